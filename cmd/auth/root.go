@@ -0,0 +1,20 @@
+package auth
+
+import (
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+	"github.com/spf13/cobra"
+)
+
+func CmdAuth(rootCmd *cobra.Command, client cloudflare.CloudflareClient, credentials cloudflare.Credentials) error {
+	cmd := &cobra.Command{
+		Use:   "auth",
+		Short: "Inspect the configured credentials",
+	}
+	err := cmdAuthVerify(cmd, client, credentials)
+	if err != nil {
+		return err
+	}
+
+	rootCmd.AddCommand(cmd)
+	return nil
+}
@@ -0,0 +1,177 @@
+package auth
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jorgejr568/cloudflare-cli/cmd/exitcode"
+	"github.com/jorgejr568/cloudflare-cli/cmd/messages"
+	"github.com/jorgejr568/cloudflare-cli/cmd/output"
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+	"github.com/spf13/cobra"
+	"sort"
+	"strings"
+)
+
+const tokenStatusActive = "active"
+
+type authStatus struct {
+	Method     cloudflare.AuthMethod    `json:"method"`
+	ID         string                   `json:"id"`
+	Name       string                   `json:"name,omitempty"`
+	Email      string                   `json:"email,omitempty"`
+	Status     string                   `json:"status"`
+	NotBefore  string                   `json:"not_before,omitempty"`
+	ExpiresOn  string                   `json:"expires_on,omitempty"`
+	LastUsedOn string                   `json:"last_used_on,omitempty"`
+	AllowedIPs []string                 `json:"allowed_ips,omitempty"`
+	DeniedIPs  []string                 `json:"denied_ips,omitempty"`
+	Policies   []cloudflare.TokenPolicy `json:"policies,omitempty"`
+}
+
+func cmdAuthVerify(rootCmd *cobra.Command, client cloudflare.CloudflareClient, credentials cloudflare.Credentials) error {
+	cmd := &cobra.Command{
+		Use:     "verify",
+		Aliases: []string{"whoami"},
+		Short:   "Verify the configured credentials and show what they give access to",
+		Long: "Verifies the configured credentials. For API tokens it shows the status, validity period, IP restrictions " +
+			"and the permission groups and resources of every policy; reading the policies requires the token to have the " +
+			"\"API Tokens Read\" permission. For a Global API Key it shows the user the key belongs to.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var status authStatus
+			switch credentials.Method {
+			case cloudflare.AuthMethodGlobalKey:
+				response, err := client.GetUser(cmd.Context())
+				if err != nil {
+					return err
+				}
+				status = authStatus{
+					Method: credentials.Method,
+					ID:     response.User.ID,
+					Name:   strings.TrimSpace(response.User.FirstName + " " + response.User.LastName),
+					Email:  response.User.Email,
+					Status: tokenStatusActive,
+				}
+				if response.User.Suspended {
+					status.Status = "suspended"
+				}
+			case cloudflare.AuthMethodOriginCAKey:
+				return exitcode.Usagef("Origin CA keys cannot be verified, they are only accepted by the Origin CA endpoints")
+			default:
+				var err error
+				status, err = acquireTokenStatus(cmd, client)
+				if err != nil {
+					return err
+				}
+			}
+
+			if err := printStatus(cmd, status); err != nil {
+				return err
+			}
+			if status.Status != tokenStatusActive {
+				return fmt.Errorf("%w: credentials are %s", cloudflare.ErrUnauthorized, status.Status)
+			}
+			return nil
+		},
+	}
+
+	rootCmd.AddCommand(cmd)
+	return nil
+}
+
+// acquireTokenStatus verifies the token and adds its details when the token is allowed to read them.
+func acquireTokenStatus(cmd *cobra.Command, client cloudflare.CloudflareClient) (authStatus, error) {
+	response, err := client.VerifyToken(cmd.Context())
+	if err != nil {
+		return authStatus{}, err
+	}
+	verified := response.Token
+
+	status := authStatus{
+		Method:    cloudflare.AuthMethodToken,
+		ID:        verified.ID,
+		Status:    verified.Status,
+		NotBefore: verified.NotBefore,
+		ExpiresOn: verified.ExpiresOn,
+	}
+
+	details, err := client.GetToken(cmd.Context(), cloudflare.GetTokenRequest{TokenID: verified.ID})
+	if err != nil {
+		if errors.Is(err, cloudflare.ErrForbidden) || errors.Is(err, cloudflare.ErrUnauthorized) {
+			cmd.Print(messages.WarningMessage("The token cannot read its own details, grant it the \"API Tokens Read\" permission to list its policies"))
+			return status, nil
+		}
+		return authStatus{}, err
+	}
+
+	token := details.Token
+	status.Name = token.Name
+	status.LastUsedOn = token.LastUsedOn
+	status.Policies = token.Policies
+	if token.Condition.RequestIP != nil {
+		status.AllowedIPs = token.Condition.RequestIP.In
+		status.DeniedIPs = token.Condition.RequestIP.NotIn
+	}
+	return status, nil
+}
+
+func printStatus(cmd *cobra.Command, status authStatus) error {
+	summary := output.Table{
+		Header: table.Row{"Field", "Value"},
+		Rows: []table.Row{
+			{"Method", status.Method},
+			{"ID", status.ID},
+			{"Name", status.Name},
+			{"Email", status.Email},
+			{"Status", status.Status},
+			{"Not before", status.NotBefore},
+			{"Expires on", status.ExpiresOn},
+			{"Last used on", status.LastUsedOn},
+			{"Allowed IPs", strings.Join(status.AllowedIPs, ", ")},
+			{"Denied IPs", strings.Join(status.DeniedIPs, ", ")},
+		},
+	}
+	if err := output.Print(cmd, status, summary); err != nil {
+		return err
+	}
+
+	format, err := output.AcquireFormat(cmd)
+	if err != nil {
+		return err
+	}
+	if format != output.FormatTable || len(status.Policies) == 0 {
+		return nil
+	}
+
+	policies := output.Table{
+		Header: table.Row{"Effect", "Permission Groups", "Resources"},
+	}
+	for _, policy := range status.Policies {
+		groups := make([]string, 0, len(policy.PermissionGroups))
+		for _, group := range policy.PermissionGroups {
+			groups = append(groups, group.Name)
+		}
+		policies.Rows = append(policies.Rows, table.Row{
+			policy.Effect,
+			strings.Join(groups, "\n"),
+			formatResources(policy.Resources),
+		})
+	}
+	return output.Print(cmd, status.Policies, policies)
+}
+
+// formatResources renders one resource per line, eg. "com.cloudflare.api.account.zone.<id> = *".
+func formatResources(resources map[string]interface{}) string {
+	lines := make([]string, 0, len(resources))
+	for resource, value := range resources {
+		text, ok := value.(string)
+		if !ok {
+			raw, _ := json.Marshal(value)
+			text = string(raw)
+		}
+		lines = append(lines, fmt.Sprintf("%s = %s", resource, text))
+	}
+	sort.Strings(lines)
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,29 @@
+package cache
+
+import (
+	"github.com/jorgejr568/cloudflare-cli/cmd/messages"
+	"github.com/jorgejr568/cloudflare-cli/internal/constants"
+	"github.com/jorgejr568/cloudflare-cli/internal/zonecache"
+	"github.com/spf13/cobra"
+)
+
+func cmdCacheClear(rootCmd *cobra.Command, zoneCache *zonecache.Cache) error {
+	cmd := &cobra.Command{
+		Use:   "clear",
+		Short: "Forget the cached zone IDs of every profile",
+		Annotations: map[string]string{
+			constants.AnnotationProfileOptional: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := zoneCache.Clear(); err != nil {
+				return err
+			}
+
+			cmd.Print(messages.SuccessMessage("Zone cache cleared"))
+			return nil
+		},
+	}
+
+	rootCmd.AddCommand(cmd)
+	return nil
+}
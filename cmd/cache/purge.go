@@ -0,0 +1,261 @@
+package cache
+
+import (
+	"bufio"
+	"fmt"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jorgejr568/cloudflare-cli/cmd/exitcode"
+	"github.com/jorgejr568/cloudflare-cli/cmd/messages"
+	"github.com/jorgejr568/cloudflare-cli/cmd/output"
+	"github.com/jorgejr568/cloudflare-cli/cmd/prompt"
+	"github.com/jorgejr568/cloudflare-cli/cmd/zoneid"
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+	"github.com/jorgejr568/cloudflare-cli/internal/constants"
+	"github.com/spf13/cobra"
+	"io"
+	"os"
+	"strings"
+)
+
+type purgeStatus string
+
+const (
+	purgeStatusPurged purgeStatus = "purged"
+	purgeStatusFailed purgeStatus = "failed"
+)
+
+type purgeKind string
+
+const (
+	purgeKindEverything purgeKind = "everything"
+	purgeKindURLs       purgeKind = "urls"
+	purgeKindTags       purgeKind = "tags"
+	purgeKindHosts      purgeKind = "hosts"
+	purgeKindPrefixes   purgeKind = "prefixes"
+)
+
+type purgeResult struct {
+	Batch   int         `json:"batch"`
+	Kind    purgeKind   `json:"kind"`
+	Items   []string    `json:"items,omitempty"`
+	Status  purgeStatus `json:"status"`
+	ID      string      `json:"id,omitempty"`
+	Message string      `json:"message,omitempty"`
+}
+
+func cmdCachePurge(rootCmd *cobra.Command, client cloudflare.CloudflareClient) error {
+	cmd := &cobra.Command{
+		Use:   "purge",
+		Short: "Purge the Cloudflare cache of a zone",
+		Long: "Purges everything cached for a zone, or only the URLs, cache tags, hosts or prefixes given. " +
+			fmt.Sprintf("Lists are sent in batches of %d, the most a single request accepts.", cloudflare.PurgeCacheBatchSize),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			requests, err := acquirePurgeRequests(cmd)
+			if err != nil {
+				return err
+			}
+
+			domain := cmd.Flag(constants.FlagDomain).Value.String()
+			zoneID, err := zoneid.Acquire(cmd, client, domain)
+			if err != nil {
+				return err
+			}
+
+			if requests[0].PurgeEverything {
+				if err := prompt.Confirm(cmd, fmt.Sprintf("Purge everything cached for %s?", domain), nil); err != nil {
+					return err
+				}
+			}
+
+			var results []purgeResult
+			// failure keeps the first error so the exit code reflects why the purge did not complete.
+			var failure error
+			for i, request := range requests {
+				request.ZoneID = zoneID
+				result := purgeResult{
+					Batch: i + 1,
+					Kind:  purgeKindOf(request),
+					Items: purgeItemsOf(request),
+				}
+
+				response, err := client.PurgeCache(cmd.Context(), request)
+				if err != nil {
+					if failure == nil {
+						failure = err
+					}
+					result.Status = purgeStatusFailed
+					result.Message = err.Error()
+					results = append(results, result)
+					continue
+				}
+
+				result.Status = purgeStatusPurged
+				result.ID = response.Purge.ID
+				results = append(results, result)
+			}
+
+			t := output.Table{
+				Header: table.Row{"Batch", "Kind", "Items", "Status", "Message"},
+			}
+			counts := map[purgeStatus]int{}
+			for _, result := range results {
+				counts[result.Status]++
+				t.Rows = append(t.Rows, table.Row{
+					result.Batch,
+					result.Kind,
+					len(result.Items),
+					result.Status,
+					result.Message,
+				})
+			}
+			if err := output.Print(cmd, results, t); err != nil {
+				return err
+			}
+
+			summary := fmt.Sprintf("Purge finished: %d batches purged, %d failed", counts[purgeStatusPurged], counts[purgeStatusFailed])
+			if failure != nil {
+				return fmt.Errorf("%s: %w", summary, failure)
+			}
+			cmd.Print(messages.SuccessMessage(summary))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP(constants.FlagDomain, "d", "", "The zone to purge the cache of")
+	zoneid.AddFlag(cmd.Flags())
+	cmd.Flags().Bool(constants.FlagEverything, false, "Purge everything cached for the zone")
+	cmd.Flags().StringArray(constants.FlagURLs, []string{}, "URL to purge, repeat the flag for several")
+	cmd.Flags().StringP(constants.FlagFile, "f", "", "File with a URL to purge per line, - reads them from stdin")
+	cmd.Flags().StringSlice(constants.FlagTags, []string{}, "Cache tags to purge")
+	cmd.Flags().StringSlice(constants.FlagHosts, []string{}, "Hosts to purge, eg. www.example.com")
+	cmd.Flags().StringArray(constants.FlagPrefixes, []string{}, "URL prefix to purge, eg. www.example.com/assets, repeat the flag for several")
+
+	cmd.MarkFlagRequired(constants.FlagDomain)
+	cmd.MarkFlagsOneRequired(constants.FlagEverything, constants.FlagURLs, constants.FlagFile, constants.FlagTags, constants.FlagHosts, constants.FlagPrefixes)
+	cmd.MarkFlagsMutuallyExclusive(constants.FlagEverything, constants.FlagURLs)
+	cmd.MarkFlagsMutuallyExclusive(constants.FlagEverything, constants.FlagFile)
+	cmd.MarkFlagsMutuallyExclusive(constants.FlagEverything, constants.FlagTags)
+	cmd.MarkFlagsMutuallyExclusive(constants.FlagEverything, constants.FlagHosts)
+	cmd.MarkFlagsMutuallyExclusive(constants.FlagEverything, constants.FlagPrefixes)
+
+	rootCmd.AddCommand(cmd)
+	return nil
+}
+
+// acquirePurgeRequests turns the flags into purge requests of a single kind and at most PurgeCacheBatchSize items each.
+func acquirePurgeRequests(cmd *cobra.Command) ([]cloudflare.PurgeCacheRequest, error) {
+	everything, err := cmd.Flags().GetBool(constants.FlagEverything)
+	if err != nil {
+		return nil, err
+	}
+	if everything {
+		return []cloudflare.PurgeCacheRequest{{PurgeEverything: true}}, nil
+	}
+
+	urls, err := cmd.Flags().GetStringArray(constants.FlagURLs)
+	if err != nil {
+		return nil, err
+	}
+	if path := cmd.Flag(constants.FlagFile).Value.String(); path != "" {
+		fromFile, err := readURLs(cmd, path)
+		if err != nil {
+			return nil, err
+		}
+		urls = append(urls, fromFile...)
+	}
+	tags, err := cmd.Flags().GetStringSlice(constants.FlagTags)
+	if err != nil {
+		return nil, err
+	}
+	hosts, err := cmd.Flags().GetStringSlice(constants.FlagHosts)
+	if err != nil {
+		return nil, err
+	}
+	prefixes, err := cmd.Flags().GetStringArray(constants.FlagPrefixes)
+	if err != nil {
+		return nil, err
+	}
+
+	var requests []cloudflare.PurgeCacheRequest
+	for _, batch := range chunk(urls, cloudflare.PurgeCacheBatchSize) {
+		requests = append(requests, cloudflare.PurgeCacheRequest{Files: batch})
+	}
+	for _, batch := range chunk(tags, cloudflare.PurgeCacheBatchSize) {
+		requests = append(requests, cloudflare.PurgeCacheRequest{Tags: batch})
+	}
+	for _, batch := range chunk(hosts, cloudflare.PurgeCacheBatchSize) {
+		requests = append(requests, cloudflare.PurgeCacheRequest{Hosts: batch})
+	}
+	for _, batch := range chunk(prefixes, cloudflare.PurgeCacheBatchSize) {
+		requests = append(requests, cloudflare.PurgeCacheRequest{Prefixes: batch})
+	}
+	if len(requests) == 0 {
+		return nil, exitcode.Usagef("nothing to purge, --%s has no URLs", constants.FlagFile)
+	}
+	return requests, nil
+}
+
+// readURLs reads a URL per line from the file, or from stdin when the path is -. Blank lines and lines starting
+// with # are skipped.
+func readURLs(cmd *cobra.Command, path string) ([]string, error) {
+	var reader io.Reader = cmd.InOrStdin()
+	if path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		reader = file
+	}
+
+	var urls []string
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		urls = append(urls, line)
+	}
+	return urls, scanner.Err()
+}
+
+func chunk(items []string, size int) [][]string {
+	var chunks [][]string
+	for len(items) > size {
+		chunks = append(chunks, items[:size])
+		items = items[size:]
+	}
+	if len(items) > 0 {
+		chunks = append(chunks, items)
+	}
+	return chunks
+}
+
+func purgeKindOf(request cloudflare.PurgeCacheRequest) purgeKind {
+	switch {
+	case request.PurgeEverything:
+		return purgeKindEverything
+	case len(request.Tags) > 0:
+		return purgeKindTags
+	case len(request.Hosts) > 0:
+		return purgeKindHosts
+	case len(request.Prefixes) > 0:
+		return purgeKindPrefixes
+	default:
+		return purgeKindURLs
+	}
+}
+
+func purgeItemsOf(request cloudflare.PurgeCacheRequest) []string {
+	switch purgeKindOf(request) {
+	case purgeKindTags:
+		return request.Tags
+	case purgeKindHosts:
+		return request.Hosts
+	case purgeKindPrefixes:
+		return request.Prefixes
+	default:
+		return request.Files
+	}
+}
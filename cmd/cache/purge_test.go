@@ -0,0 +1,187 @@
+package cache
+
+import (
+	"fmt"
+	"github.com/jorgejr568/cloudflare-cli/cmd/exitcode"
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+	"github.com/jorgejr568/cloudflare-cli/internal/constants"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func urls(n int) []string {
+	items := make([]string, n)
+	for i := range items {
+		items[i] = fmt.Sprintf("https://example.com/%d", i)
+	}
+	return items
+}
+
+func batchSizes(batches [][]string) []int {
+	sizes := []int{}
+	for _, batch := range batches {
+		sizes = append(sizes, len(batch))
+	}
+	return sizes
+}
+
+func newPurgeCommand(t *testing.T, args ...string) *cobra.Command {
+	root := &cobra.Command{Use: "cache"}
+	assert.NoError(t, cmdCachePurge(root, nil))
+	cmd, _, err := root.Find([]string{"purge"})
+	assert.NoError(t, err)
+	assert.NoError(t, cmd.ParseFlags(args))
+	return cmd
+}
+
+func TestChunk(t *testing.T) {
+	tests := []struct {
+		name  string
+		items int
+		want  []int
+	}{
+		{
+			name:  "it should return no batches without items",
+			items: 0,
+			want:  []int{},
+		},
+		{
+			name:  "it should fit a full batch in one request",
+			items: 30,
+			want:  []int{30},
+		},
+		{
+			name:  "it should start a new batch after 30 items",
+			items: 31,
+			want:  []int{30, 1},
+		},
+		{
+			name:  "it should split into as many batches as needed",
+			items: 61,
+			want:  []int{30, 30, 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items := urls(tt.items)
+			batches := chunk(items, cloudflare.PurgeCacheBatchSize)
+			assert.Equal(t, tt.want, batchSizes(batches))
+
+			var joined []string
+			for _, batch := range batches {
+				joined = append(joined, batch...)
+			}
+			assert.Equal(t, len(items), len(joined))
+			if len(items) > 0 {
+				assert.Equal(t, items, joined, "it should keep every item in order")
+			}
+		})
+	}
+}
+
+func TestReadURLs(t *testing.T) {
+	content := strings.Join([]string{
+		"# assets",
+		"https://example.com/a.css",
+		"",
+		"   ",
+		"  https://example.com/b.js  ",
+		"  # https://example.com/skipped.js",
+		"https://example.com/c.png",
+	}, "\n")
+	want := []string{"https://example.com/a.css", "https://example.com/b.js", "https://example.com/c.png"}
+
+	t.Run("it should read a file skipping blank and comment lines", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "urls.txt")
+		assert.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+		got, err := readURLs(&cobra.Command{}, path)
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("it should read stdin when the path is -", func(t *testing.T) {
+		cmd := &cobra.Command{}
+		cmd.SetIn(strings.NewReader(content))
+
+		got, err := readURLs(cmd, "-")
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("it should fail on a missing file", func(t *testing.T) {
+		_, err := readURLs(&cobra.Command{}, filepath.Join(t.TempDir(), "missing.txt"))
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
+
+func TestAcquirePurgeRequests(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      []string
+		stdin     string
+		wantKinds []purgeKind
+		wantSizes []int
+		wantErr   error
+	}{
+		{
+			name:      "it should purge everything in one request",
+			args:      []string{"--" + constants.FlagEverything},
+			wantKinds: []purgeKind{purgeKindEverything},
+			wantSizes: []int{0},
+		},
+		{
+			name:      "it should batch URLs from flags and stdin together",
+			args:      append(urlFlags(1), "--"+constants.FlagFile, "-"),
+			stdin:     strings.Join(urls(30), "\n"),
+			wantKinds: []purgeKind{purgeKindURLs, purgeKindURLs},
+			wantSizes: []int{30, 1},
+		},
+		{
+			name:      "it should send a request per kind",
+			args:      []string{"--" + constants.FlagTags, "a,b", "--" + constants.FlagHosts, "www.example.com", "--" + constants.FlagPrefixes, "www.example.com/assets"},
+			wantKinds: []purgeKind{purgeKindTags, purgeKindHosts, purgeKindPrefixes},
+			wantSizes: []int{2, 1, 1},
+		},
+		{
+			name:    "it should refuse a file without URLs",
+			args:    []string{"--" + constants.FlagFile, "-"},
+			stdin:   "# nothing yet\n\n",
+			wantErr: exitcode.ErrUsage,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := newPurgeCommand(t, tt.args...)
+			cmd.SetIn(strings.NewReader(tt.stdin))
+
+			requests, err := acquirePurgeRequests(cmd)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+
+			var kinds []purgeKind
+			var sizes []int
+			for _, request := range requests {
+				kinds = append(kinds, purgeKindOf(request))
+				sizes = append(sizes, len(purgeItemsOf(request)))
+			}
+			assert.Equal(t, tt.wantKinds, kinds)
+			assert.Equal(t, tt.wantSizes, sizes)
+		})
+	}
+}
+
+func urlFlags(n int) []string {
+	var args []string
+	for _, url := range urls(n) {
+		args = append(args, "--"+constants.FlagURLs, url)
+	}
+	return args
+}
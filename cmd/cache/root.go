@@ -0,0 +1,26 @@
+package cache
+
+import (
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+	"github.com/jorgejr568/cloudflare-cli/internal/zonecache"
+	"github.com/spf13/cobra"
+)
+
+func CmdCache(rootCmd *cobra.Command, client cloudflare.CloudflareClient, zoneCache *zonecache.Cache) error {
+	cmd := &cobra.Command{
+		Use:   "cache",
+		Short: "Purge the Cloudflare cache and manage the local zone cache",
+	}
+	err := cmdCachePurge(cmd, client)
+	if err != nil {
+		return err
+	}
+
+	err = cmdCacheClear(cmd, zoneCache)
+	if err != nil {
+		return err
+	}
+
+	rootCmd.AddCommand(cmd)
+	return nil
+}
@@ -0,0 +1,66 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/jorgejr568/cloudflare-cli/internal/constants"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+var profileKeys = map[string]string{
+	"auth_method":               "global_key",
+	"cloudflare_api_key":        "api-token",
+	"cloudflare_email":          "user@example.com",
+	"cloudflare_global_api_key": "global-key",
+	"cloudflare_origin_ca_key":  "v1.0-origin-ca-key",
+}
+
+func execute(t *testing.T, args ...string) (string, error) {
+	root := &cobra.Command{Use: "cloudflare-cli", SilenceErrors: true, SilenceUsage: true}
+	root.PersistentFlags().String(constants.FlagProfile, "", "")
+	root.PersistentFlags().StringP(constants.FlagOutput, "o", "table", "")
+	root.PersistentFlags().String(constants.FlagTemplate, "", "")
+	assert.NoError(t, CmdConfig(root))
+
+	var stdout bytes.Buffer
+	root.SetOut(&stdout)
+	root.SetErr(&bytes.Buffer{})
+	root.SetArgs(args)
+	err := root.Execute()
+	return stdout.String(), err
+}
+
+func TestConfig_SetGetList(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("CLOUDFLARE_PROFILE", "")
+
+	for key, value := range profileKeys {
+		_, err := execute(t, "config", "set", key, value)
+		assert.NoError(t, err, "it should set %s", key)
+	}
+
+	for key, value := range profileKeys {
+		out, err := execute(t, "config", "get", key)
+		assert.NoError(t, err, "it should get %s", key)
+		assert.Equal(t, value, strings.TrimSpace(out))
+	}
+
+	out, err := execute(t, "config", "list", "-o", "json")
+	assert.NoError(t, err)
+	var listed map[string]string
+	assert.NoError(t, json.Unmarshal([]byte(out), &listed))
+	assert.Equal(t, profileKeys, listed, "it should list every key by its name")
+}
+
+func TestConfig_UnknownKey(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	_, err := execute(t, "config", "set", "cloudflare_api_key,omitempty", "value")
+	assert.ErrorContains(t, err, "not found")
+
+	_, err = execute(t, "config", "get", "missing")
+	assert.ErrorContains(t, err, "not found")
+}
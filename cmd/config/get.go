@@ -1,9 +1,9 @@
 package config
 
 import (
-	"errors"
-	"github.com/jorgejr568/cloudflare-cli/cmd/messages"
-	"github.com/jorgejr568/cloudflare-cli/internal/config"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jorgejr568/cloudflare-cli/cmd/exitcode"
+	"github.com/jorgejr568/cloudflare-cli/cmd/output"
 	"github.com/spf13/cobra"
 	"reflect"
 )
@@ -11,26 +11,42 @@ import (
 func cmdConfigGet(rootCmd *cobra.Command) error {
 	cmd := &cobra.Command{
 		Use:   "get <key>",
-		Short: "List cloudflare config",
+		Short: "Get a config value of the active profile",
 		Args:  cobra.ExactArgs(1),
-		Run: func(cmd *cobra.Command, args []string) {
-			localConfig, err := config.LoadLocalConfig()
+		RunE: func(cmd *cobra.Command, args []string) error {
+			_, _, profile, err := acquireProfile(cmd)
 			if err != nil {
-				cmd.PrintErr(messages.ErrorMessage(err))
-				return
+				return err
 			}
 
-			reflection := reflect.ValueOf(localConfig)
+			reflection := reflect.ValueOf(profile)
 			for i := 0; i < reflection.NumField(); i++ {
-				if reflection.Type().Field(i).Tag.Get("json") == args[0] {
-					cmd.Println(reflection.Field(i).Interface())
-					return
+				if profileKey(reflection.Type().Field(i)) == args[0] {
+					return printValue(cmd, args[0], reflection.Field(i).Interface())
 				}
 			}
 
-			cmd.PrintErr(messages.ErrorMessage(errors.New("key not found")))
+			return exitcode.Usagef("key %s not found", args[0])
 		},
 	}
 	rootCmd.AddCommand(cmd)
 	return nil
 }
+
+// printValue keeps the bare value as the default output so it can be used directly in shell substitutions.
+func printValue(cmd *cobra.Command, key string, value interface{}) error {
+	format, err := output.AcquireFormat(cmd)
+	if err != nil {
+		return err
+	}
+
+	if format == output.FormatTable {
+		cmd.Println(value)
+		return nil
+	}
+
+	return output.Print(cmd, map[string]interface{}{key: value}, output.Table{
+		Header: table.Row{"Key", "Value"},
+		Rows:   []table.Row{{key, value}},
+	})
+}
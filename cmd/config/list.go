@@ -2,8 +2,7 @@ package config
 
 import (
 	"github.com/jedib0t/go-pretty/v6/table"
-	"github.com/jorgejr568/cloudflare-cli/cmd/messages"
-	"github.com/jorgejr568/cloudflare-cli/internal/config"
+	"github.com/jorgejr568/cloudflare-cli/cmd/output"
 	"github.com/spf13/cobra"
 	"reflect"
 )
@@ -11,26 +10,28 @@ import (
 func cmdConfigList(rootCmd *cobra.Command) error {
 	cmd := &cobra.Command{
 		Use:   "list",
-		Short: "List cloudflare config",
-		Run: func(cmd *cobra.Command, args []string) {
-			localConfig, err := config.LoadLocalConfig()
+		Short: "List the config of the active profile",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			_, _, profile, err := acquireProfile(cmd)
 			if err != nil {
-				cmd.PrintErr(messages.ErrorMessage(err))
-				return
+				return err
 			}
 
-			reflection := reflect.ValueOf(localConfig)
-			t := table.NewWriter()
-			t.SetOutputMirror(cmd.OutOrStdout())
-			t.AppendHeader(table.Row{"Key", "Value"})
+			reflection := reflect.ValueOf(profile)
+			values := map[string]interface{}{}
+			t := output.Table{
+				Header: table.Row{"Key", "Value"},
+			}
 			for i := 0; i < reflection.NumField(); i++ {
-				t.AppendRow(table.Row{
-					reflection.Type().Field(i).Tag.Get("json"),
+				key := profileKey(reflection.Type().Field(i))
+				values[key] = reflection.Field(i).Interface()
+				t.Rows = append(t.Rows, table.Row{
+					key,
 					reflection.Field(i).Interface(),
 				})
 			}
 
-			t.Render()
+			return output.Print(cmd, values, t)
 		},
 	}
 	rootCmd.AddCommand(cmd)
@@ -0,0 +1,127 @@
+package config
+
+import (
+	"fmt"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jorgejr568/cloudflare-cli/cmd/messages"
+	"github.com/jorgejr568/cloudflare-cli/cmd/output"
+	"github.com/jorgejr568/cloudflare-cli/cmd/prompt"
+	"github.com/jorgejr568/cloudflare-cli/internal/config"
+	"github.com/jorgejr568/cloudflare-cli/internal/constants"
+	"github.com/spf13/cobra"
+)
+
+type profileEntry struct {
+	Name             string `json:"name"`
+	Active           bool   `json:"active"`
+	CloudflareAPIKey string `json:"cloudflare_api_key"`
+}
+
+func cmdConfigProfiles(rootCmd *cobra.Command) error {
+	cmd := &cobra.Command{
+		Use:   "profiles",
+		Short: "Manage configuration profiles",
+	}
+
+	list := &cobra.Command{
+		Use:   "list",
+		Short: "List configuration profiles",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			localConfig, active, _, err := acquireProfile(cmd)
+			if err != nil {
+				return err
+			}
+
+			entries := []profileEntry{}
+			t := output.Table{
+				Header: table.Row{"Name", "Active", "API Key"},
+			}
+			for _, name := range localConfig.ProfileNames() {
+				profile, _ := localConfig.Profile(name)
+				entry := profileEntry{
+					Name:             name,
+					Active:           name == active,
+					CloudflareAPIKey: maskSecret(profile.CloudflareAPIKey()),
+				}
+				entries = append(entries, entry)
+				t.Rows = append(t.Rows, table.Row{entry.Name, entry.Active, entry.CloudflareAPIKey})
+			}
+
+			return output.Print(cmd, entries, t)
+		},
+	}
+
+	use := &cobra.Command{
+		Use:   "use <name>",
+		Short: "Set the profile used when --profile and CLOUDFLARE_PROFILE are not set",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			localConfig, err := config.LoadLocalConfig()
+			if err != nil {
+				return err
+			}
+			if err := localConfig.UseProfile(args[0]); err != nil {
+				return err
+			}
+			if err := config.SaveLocalConfig(localConfig); err != nil {
+				return err
+			}
+
+			cmd.Print(messages.SuccessMessage(fmt.Sprintf("Now using profile %s", args[0])))
+			return nil
+		},
+	}
+
+	remove := &cobra.Command{
+		Use:   "delete <name>",
+		Short: "Delete a profile",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			localConfig, err := config.LoadLocalConfig()
+			if err != nil {
+				return err
+			}
+			if _, ok := localConfig.Profile(args[0]); !ok {
+				return fmt.Errorf("%w: %s", config.ErrProfileNotFound, args[0])
+			}
+			if err := prompt.Confirm(cmd, fmt.Sprintf("Delete profile %s and its credentials?", args[0]), nil); err != nil {
+				return err
+			}
+
+			if err := localConfig.DeleteProfile(args[0]); err != nil {
+				return err
+			}
+			if err := config.SaveLocalConfig(localConfig); err != nil {
+				return err
+			}
+
+			cmd.Print(messages.SuccessMessage(fmt.Sprintf("Profile %s deleted", args[0])))
+			return nil
+		},
+	}
+
+	cmd.AddCommand(list, use, remove)
+	rootCmd.AddCommand(cmd)
+	return nil
+}
+
+// acquireProfile loads the local config and the profile selected by --profile, CLOUDFLARE_PROFILE or "profiles use".
+// The profile is empty when it does not exist yet.
+func acquireProfile(cmd *cobra.Command) (config.LocalConfig, string, config.Profile, error) {
+	localConfig, err := config.LoadLocalConfig()
+	if err != nil {
+		return config.LocalConfig{}, "", config.Profile{}, err
+	}
+
+	name := localConfig.ActiveProfile(cmd.Flag(constants.FlagProfile).Value.String())
+	profile, _ := localConfig.Profile(name)
+	return localConfig, name, profile, nil
+}
+
+// maskSecret keeps the last characters of a secret so profiles can be told apart without printing it.
+func maskSecret(secret string) string {
+	if len(secret) <= 4 {
+		return secret
+	}
+	return fmt.Sprintf("****%s", secret[len(secret)-4:])
+}
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"github.com/jorgejr568/cloudflare-cli/internal/constants"
 	"github.com/spf13/cobra"
 )
 
@@ -8,6 +9,9 @@ func CmdConfig(rootCmd *cobra.Command) error {
 	cmd := &cobra.Command{
 		Use:   "config",
 		Short: "Manage configuration",
+		Annotations: map[string]string{
+			constants.AnnotationProfileOptional: "true",
+		},
 	}
 	err := cmdConfigGet(cmd)
 	if err != nil {
@@ -21,6 +25,10 @@ func CmdConfig(rootCmd *cobra.Command) error {
 	if err != nil {
 		return err
 	}
+	err = cmdConfigProfiles(cmd)
+	if err != nil {
+		return err
+	}
 
 	rootCmd.AddCommand(cmd)
 	return nil
@@ -2,59 +2,67 @@ package config
 
 import (
 	"fmt"
+	"github.com/jorgejr568/cloudflare-cli/cmd/exitcode"
 	"github.com/jorgejr568/cloudflare-cli/cmd/messages"
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
 	"github.com/jorgejr568/cloudflare-cli/internal/config"
 	"github.com/spf13/cobra"
 	"reflect"
+	"strings"
 )
 
+const authMethodKey = "auth_method"
+
 func cmdConfigSet(rootCmd *cobra.Command) error {
 	cmd := &cobra.Command{
 		Use:   "set [<key> <value>...]",
-		Short: "Set cloudflare config",
+		Short: "Set config values of the active profile, creating it if needed",
 		Args:  cobra.MinimumNArgs(2),
-		Run: func(cmd *cobra.Command, args []string) {
+		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args)%2 != 0 {
-				cmd.PrintErr(messages.ErrorMessage(fmt.Errorf("invalid number of args")))
-				return
+				return exitcode.Usagef("invalid number of args, expected <key> <value> pairs")
 			}
 
-			localConfig, err := config.LoadLocalConfig()
+			localConfig, name, profile, err := acquireProfile(cmd)
 			if err != nil {
-				cmd.PrintErr(messages.ErrorMessage(err))
-				return
+				return err
 			}
 
 			for i := 0; i < len(args); i += 2 {
 				key := args[i]
 				value := args[i+1]
 
-				err = setField(&localConfig, key, value)
+				if key == authMethodKey {
+					if _, err := cloudflare.ParseAuthMethod(value); err != nil {
+						return err
+					}
+				}
+
+				err = setField(&profile, key, value)
 				if err != nil {
-					cmd.PrintErr(messages.ErrorMessage(err))
-					return
+					return err
 				}
 			}
 
+			localConfig.SetProfile(name, profile)
 			err = config.SaveLocalConfig(localConfig)
 			if err != nil {
-				cmd.PrintErr(messages.ErrorMessage(err))
-				return
+				return err
 			}
 
-			cmd.Print(messages.SuccessMessage("Config updated"))
+			cmd.Print(messages.SuccessMessage(fmt.Sprintf("Config of profile %s updated", name)))
+			return nil
 		},
 	}
 	rootCmd.AddCommand(cmd)
 	return nil
 }
 
-func setField(localConfig *config.LocalConfig, key string, value string) error {
-	reflection := reflect.ValueOf(localConfig)
+func setField(profile *config.Profile, key string, value string) error {
+	reflection := reflect.ValueOf(profile)
 	for i := 0; i < reflection.Elem().NumField(); i++ {
 		field := reflection.Elem().Field(i)
-		tag := reflection.Elem().Type().Field(i).Tag.Get("json")
-		if tag == key {
+		if profileKey(reflection.Elem().Type().Field(i)) == key {
 			if !field.CanSet() {
 				return fmt.Errorf("cannot set field %s", key)
 			}
@@ -64,5 +72,11 @@ func setField(localConfig *config.LocalConfig, key string, value string) error {
 		}
 	}
 
-	return fmt.Errorf("field %s not found", key)
+	return exitcode.Usagef("field %s not found", key)
+}
+
+// profileKey returns the config key of a profile field, its JSON name without options such as omitempty.
+func profileKey(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	return name
 }
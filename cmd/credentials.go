@@ -0,0 +1,35 @@
+package cmd
+
+import (
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+	"github.com/jorgejr568/cloudflare-cli/internal/config"
+)
+
+// acquireCredentials builds the credentials of the active profile. Without an explicit auth method it is inferred
+// from the settings present, preferring an API token, then a Global API Key, then an Origin CA key.
+func acquireCredentials(composite config.Composite) (cloudflare.Credentials, error) {
+	method := cloudflare.AuthMethod(composite.AuthMethod())
+	if method == "" {
+		switch {
+		case composite.CloudflareAPIKey() == "" && composite.CloudflareGlobalAPIKey() != "":
+			method = cloudflare.AuthMethodGlobalKey
+		case composite.CloudflareAPIKey() == "" && composite.CloudflareOriginCAKey() != "":
+			method = cloudflare.AuthMethodOriginCAKey
+		default:
+			method = cloudflare.AuthMethodToken
+		}
+	}
+
+	var credentials cloudflare.Credentials
+	switch method {
+	case cloudflare.AuthMethodGlobalKey:
+		credentials = cloudflare.GlobalAPIKeyCredentials(composite.CloudflareEmail(), composite.CloudflareGlobalAPIKey())
+	case cloudflare.AuthMethodOriginCAKey:
+		credentials = cloudflare.OriginCAKeyCredentials(composite.CloudflareOriginCAKey())
+	default:
+		credentials = cloudflare.APITokenCredentials(composite.CloudflareAPIKey())
+		credentials.Method = method
+	}
+
+	return credentials, credentials.Validate()
+}
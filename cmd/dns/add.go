@@ -1,8 +1,9 @@
 package dns
 
 import (
-	"github.com/jedib0t/go-pretty/v6/table"
-	"github.com/jorgejr568/cloudflare-cli/cmd/messages"
+	"fmt"
+	"github.com/jorgejr568/cloudflare-cli/cmd/exitcode"
+	"github.com/jorgejr568/cloudflare-cli/cmd/zoneid"
 	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
 	"github.com/jorgejr568/cloudflare-cli/internal/constants"
 	"github.com/spf13/cobra"
@@ -12,82 +13,88 @@ func cmdDnsAdd(rootCmd *cobra.Command, client cloudflare.CloudflareClient) error
 	cmd := &cobra.Command{
 		Use:   "add",
 		Short: "Add a DNS record",
-		Run: func(cmd *cobra.Command, args []string) {
+		RunE: func(cmd *cobra.Command, args []string) error {
 			domain := cmd.Flag(constants.FlagDomain).Value.String()
 			ttl, err := cmd.Flags().GetInt(constants.FlagTTL)
 			if err != nil {
-				cmd.PrintErr(messages.ErrorMessage(err))
-				return
+				return err
 			}
 			if ttl < 1 || ttl > 86400 {
-				cmd.PrintErr("TTL must be between 1 and 86400")
-				return
+				return fmt.Errorf("%w: TTL must be between 1 and 86400", cloudflare.ErrInvalidRecord)
 			}
 
 			proxied, err := cmd.Flags().GetBool(constants.FlagProxied)
 			if err != nil {
-				cmd.PrintErr(messages.ErrorMessage(err))
-				return
+				return err
 			}
 			tags, err := cmd.Flags().GetStringSlice(constants.FlagTags)
 			if err != nil {
-				cmd.PrintErr(messages.ErrorMessage(err))
-				return
+				return err
 			}
 
 			zoneType, err := cloudflare.ParseZoneType(cmd.Flag(constants.FlagType).Value.String())
 			if err != nil {
-				cmd.PrintErr(messages.ErrorMessage(err))
-				return
+				return err
+			}
+
+			content := cmd.Flag(constants.FlagContent).Value.String()
+			data, err := acquireRecordData(cmd, zoneType)
+			if err != nil {
+				return err
+			}
+			priority, err := acquirePriority(cmd, zoneType)
+			if err != nil {
+				return err
+			}
+			if data == nil && content == "" {
+				return exitcode.Usagef("--%s is required for %s records", constants.FlagContent, zoneType)
 			}
 
 			record := cloudflare.ZoneRecordRequest{
-				Type:    zoneType,
-				Name:    acquireEntryFullName(domain, cmd.Flag(constants.FlagName).Value.String()),
-				Content: cmd.Flag(constants.FlagContent).Value.String(),
-				TTL:     ttl,
-				Proxied: proxied,
-				Tags:    tags,
-				Comment: cmd.Flag(constants.FlagComment).Value.String(),
+				Type:     zoneType,
+				Name:     acquireEntryFullName(domain, cmd.Flag(constants.FlagName).Value.String()),
+				Content:  content,
+				Priority: priority,
+				Data:     data,
+				TTL:      ttl,
+				Proxied:  proxied,
+				Tags:     tags,
+				Comment:  cmd.Flag(constants.FlagComment).Value.String(),
 			}
 
-			zone, err := client.GetZoneByDomain(cmd.Context(), cloudflare.GetZoneByDomainRequest{
-				Domain: domain,
+			zoneID, err := zoneid.Acquire(cmd, client, domain)
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.GetZoneRecords(cmd.Context(), cloudflare.GetZoneRecordsRequest{
+				ZoneID: zoneID,
+				Name:   record.Name,
 			})
 			if err != nil {
-				cmd.PrintErr(messages.ErrorMessage(err))
-				return
+				return err
+			}
+			if err := cloudflare.ValidateZoneRecord(domain, record, existing.Records); err != nil {
+				return err
 			}
 
 			response, err := client.AddZoneRecord(cmd.Context(), cloudflare.AddZoneRecordRequest{
-				ZoneID: zone.ZoneID,
+				ZoneID: zoneID,
 				Record: record,
 			})
 			if err != nil {
-				cmd.PrintErr(messages.ErrorMessage(err))
-				return
+				return err
 			}
 
-			t := table.NewWriter()
-			t.SetOutputMirror(cmd.OutOrStdout())
-			t.AppendHeader(table.Row{"ID", "Type", "Name", "Content", "Proxied", "TTL", "Tags", "Comment"})
-			t.AppendRow(table.Row{
-				response.Record.ID,
-				response.Record.Type,
-				response.Record.Name,
-				response.Record.Content,
-				response.Record.Proxied,
-				response.Record.TTL,
-				response.Record.Tags,
-				response.Record.Comment,
-			})
-			t.Render()
+			return printRecord(cmd, response.Record)
 		},
 	}
 
 	cmd.Flags().String(constants.FlagName, "", "Name of the record")
 	cmd.Flags().String(constants.FlagType, "", "Type of the record")
 	cmd.Flags().String(constants.FlagContent, "", "Content of the record")
+	cmd.Flags().StringArray(constants.FlagData, []string{}, "Structured data field of the record as key=value, required by SRV, CAA, LOC, TLSA and similar types. Repeat for each field")
+	cmd.Flags().Int(constants.FlagPriority, 0, "Priority of MX, SRV and URI records (0-65535)")
 	cmd.Flags().Int(constants.FlagTTL, 1, "TTL of the record (1-86400)")
 	cmd.Flags().Bool(constants.FlagProxied, false, "Proxied status of the record")
 	cmd.Flags().StringSlice(constants.FlagTags, []string{}, "Tags of the record")
@@ -95,7 +102,6 @@ func cmdDnsAdd(rootCmd *cobra.Command, client cloudflare.CloudflareClient) error
 
 	cmd.MarkFlagRequired(constants.FlagName)
 	cmd.MarkFlagRequired(constants.FlagType)
-	cmd.MarkFlagRequired(constants.FlagContent)
 
 	rootCmd.AddCommand(cmd)
 	return nil
@@ -0,0 +1,75 @@
+package dns
+
+import (
+	"fmt"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jorgejr568/cloudflare-cli/cmd/messages"
+	"github.com/jorgejr568/cloudflare-cli/cmd/output"
+	"github.com/jorgejr568/cloudflare-cli/cmd/prompt"
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+	"github.com/jorgejr568/cloudflare-cli/internal/reconcile"
+	"github.com/spf13/cobra"
+)
+
+func cmdDnsApply(rootCmd *cobra.Command, client cloudflare.CloudflareClient) error {
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Reconcile a zone with a desired-state file",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			zoneID, plan, err := acquirePlan(cmd, client)
+			if err != nil {
+				return err
+			}
+
+			if !plan.HasChanges() {
+				return printPlan(cmd, plan)
+			}
+
+			if deletes := plan.Count(reconcile.ActionDelete); deletes > 0 {
+				err := prompt.Confirm(cmd, fmt.Sprintf("Apply %d changes, deleting %d records?", len(plan.Changes), deletes), func() error {
+					return printPlan(cmd, plan)
+				})
+				if err != nil {
+					return err
+				}
+			}
+
+			results, applyErr := reconcile.Apply(cmd.Context(), client, zoneID, plan)
+			t := output.Table{
+				Header: table.Row{"Action", "Type", "Name", "Content", "Status"},
+			}
+			for _, result := range results {
+				status := "ok"
+				if result.Err != nil {
+					status = result.Err.Error()
+				}
+				t.Rows = append(t.Rows, table.Row{
+					result.Change.Action,
+					result.Change.Type(),
+					result.Change.Name(),
+					result.Change.Content(),
+					status,
+				})
+			}
+			if err := output.Print(cmd, results, t); err != nil {
+				return err
+			}
+
+			if applyErr != nil {
+				return fmt.Errorf("apply stopped after %d of %d changes: %w", len(results)-1, len(plan.Changes), applyErr)
+			}
+
+			cmd.Print(messages.SuccessMessage(fmt.Sprintf("Apply complete: %d created, %d updated, %d deleted",
+				plan.Count(reconcile.ActionCreate),
+				plan.Count(reconcile.ActionUpdate),
+				plan.Count(reconcile.ActionDelete),
+			)))
+			return nil
+		},
+	}
+
+	addPlanFlags(cmd)
+
+	rootCmd.AddCommand(cmd)
+	return nil
+}
@@ -0,0 +1,153 @@
+package dns
+
+import (
+	"fmt"
+	"github.com/jorgejr568/cloudflare-cli/cmd/exitcode"
+	"github.com/jorgejr568/cloudflare-cli/cmd/messages"
+	"github.com/jorgejr568/cloudflare-cli/cmd/zoneid"
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+	"github.com/jorgejr568/cloudflare-cli/internal/constants"
+	"github.com/jorgejr568/cloudflare-cli/internal/ddns"
+	"github.com/spf13/cobra"
+	"os/signal"
+	"syscall"
+)
+
+const (
+	defaultIPv4Source = "https://api.ipify.org"
+	defaultIPv6Source = "https://api6.ipify.org"
+)
+
+func cmdDnsDdns(rootCmd *cobra.Command, client cloudflare.CloudflareClient) error {
+	cmd := &cobra.Command{
+		Use:   "ddns",
+		Short: "Keep A/AAAA records pointing at this machine's public address",
+		Long: "Detects the public address of this machine and keeps the A and/or AAAA record of --name in sync with it, " +
+			"checking every --interval until interrupted. The address is read from an http(s) echo URL, " +
+			"a local interface (interface:<name>) or a shell command (command:<command>).",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			domain := cmd.Flag(constants.FlagDomain).Value.String()
+			name := acquireEntryFullName(domain, cmd.Flag(constants.FlagName).Value.String())
+
+			ttl, err := cmd.Flags().GetInt(constants.FlagTTL)
+			if err != nil {
+				return err
+			}
+			proxied, err := cmd.Flags().GetBool(constants.FlagProxied)
+			if err != nil {
+				return err
+			}
+			options, err := acquireDdnsOptions(cmd)
+			if err != nil {
+				return err
+			}
+
+			families := map[ddns.Family]string{
+				ddns.FamilyIPv4: constants.FlagIPv4,
+				ddns.FamilyIPv6: constants.FlagIPv6,
+			}
+			sources := map[ddns.Family]string{
+				ddns.FamilyIPv4: constants.FlagIPv4Source,
+				ddns.FamilyIPv6: constants.FlagIPv6Source,
+			}
+
+			zoneID, err := zoneid.Acquire(cmd, client, domain)
+			if err != nil {
+				return err
+			}
+
+			var updaters []*ddns.Updater
+			for _, family := range []ddns.Family{ddns.FamilyIPv4, ddns.FamilyIPv6} {
+				enabled, err := cmd.Flags().GetBool(families[family])
+				if err != nil {
+					return err
+				}
+				if !enabled {
+					continue
+				}
+
+				source, err := ddns.ParseSource(cmd.Flag(sources[family]).Value.String())
+				if err != nil {
+					return err
+				}
+				updaters = append(updaters, &ddns.Updater{
+					Client:  client,
+					Zone:    domain,
+					ZoneID:  zoneID,
+					Name:    name,
+					Family:  family,
+					Source:  source,
+					TTL:     ttl,
+					Proxied: proxied,
+				})
+			}
+			if len(updaters) == 0 {
+				return exitcode.Usagef("at least one of --%s or --%s must be enabled", constants.FlagIPv4, constants.FlagIPv6)
+			}
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), syscall.SIGINT, syscall.SIGTERM)
+			defer stop()
+
+			return ddns.Run(ctx, updaters, options, func(result ddns.Result) {
+				switch {
+				case result.Err != nil:
+					cmd.PrintErr(messages.ErrorMessage(fmt.Errorf("%s %s: %w", result.Type, result.Name, result.Err)))
+				case result.Action == ddns.ActionUnchanged:
+					cmd.Print(messages.SuccessMessage(fmt.Sprintf("%s %s is up to date (%s)", result.Type, result.Name, result.IP)))
+				default:
+					cmd.Print(messages.SuccessMessage(fmt.Sprintf("%s %s %s with %s", result.Type, result.Name, result.Action, result.IP)))
+				}
+			})
+		},
+	}
+
+	cmd.Flags().String(constants.FlagName, "", "Name of the record to keep updated")
+	cmd.Flags().Bool(constants.FlagIPv4, true, "Keep the A record updated")
+	cmd.Flags().Bool(constants.FlagIPv6, false, "Keep the AAAA record updated")
+	cmd.Flags().String(constants.FlagIPv4Source, defaultIPv4Source, "Where to detect the IPv4 address: an http(s) URL, interface:<name> or command:<command>")
+	cmd.Flags().String(constants.FlagIPv6Source, defaultIPv6Source, "Where to detect the IPv6 address: an http(s) URL, interface:<name> or command:<command>")
+	cmd.Flags().Duration(constants.FlagInterval, ddns.DefaultInterval, "How often to check the address")
+	cmd.Flags().Duration(constants.FlagInitialBackoff, ddns.DefaultInitialBackoff, "Delay before retrying after a failed check, doubled on each consecutive failure")
+	cmd.Flags().Duration(constants.FlagMaxBackoff, ddns.DefaultMaxBackoff, "Maximum delay between retries after failed checks")
+	cmd.Flags().Bool(constants.FlagOnce, false, "Check and update once, then exit")
+	cmd.Flags().Int(constants.FlagTTL, 1, "TTL of the record when it is created (1-86400)")
+	cmd.Flags().Bool(constants.FlagProxied, false, "Proxied status of the record when it is created")
+
+	cmd.MarkFlagRequired(constants.FlagName)
+
+	rootCmd.AddCommand(cmd)
+	return nil
+}
+
+func acquireDdnsOptions(cmd *cobra.Command) (ddns.Options, error) {
+	interval, err := cmd.Flags().GetDuration(constants.FlagInterval)
+	if err != nil {
+		return ddns.Options{}, err
+	}
+	initialBackoff, err := cmd.Flags().GetDuration(constants.FlagInitialBackoff)
+	if err != nil {
+		return ddns.Options{}, err
+	}
+	maxBackoff, err := cmd.Flags().GetDuration(constants.FlagMaxBackoff)
+	if err != nil {
+		return ddns.Options{}, err
+	}
+	once, err := cmd.Flags().GetBool(constants.FlagOnce)
+	if err != nil {
+		return ddns.Options{}, err
+	}
+
+	if interval <= 0 || initialBackoff <= 0 || maxBackoff <= 0 {
+		return ddns.Options{}, exitcode.Usagef("--%s, --%s and --%s must be positive", constants.FlagInterval, constants.FlagInitialBackoff, constants.FlagMaxBackoff)
+	}
+	if initialBackoff > maxBackoff {
+		return ddns.Options{}, exitcode.Usagef("--%s must not exceed --%s", constants.FlagInitialBackoff, constants.FlagMaxBackoff)
+	}
+
+	return ddns.Options{
+		Interval:       interval,
+		InitialBackoff: initialBackoff,
+		MaxBackoff:     maxBackoff,
+		Once:           once,
+	}, nil
+}
@@ -3,98 +3,209 @@ package dns
 import (
 	"fmt"
 	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jorgejr568/cloudflare-cli/cmd/exitcode"
 	"github.com/jorgejr568/cloudflare-cli/cmd/messages"
+	"github.com/jorgejr568/cloudflare-cli/cmd/output"
+	"github.com/jorgejr568/cloudflare-cli/cmd/prompt"
+	"github.com/jorgejr568/cloudflare-cli/cmd/zoneid"
+	"github.com/jorgejr568/cloudflare-cli/internal/bulk"
 	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
 	"github.com/jorgejr568/cloudflare-cli/internal/constants"
 	"github.com/spf13/cobra"
+	"regexp"
+)
+
+type deleteStatus string
+
+const (
+	deleteStatusDeleted deleteStatus = "deleted"
+	deleteStatusFailed  deleteStatus = "failed"
 )
 
 func cmdDnsDelete(rootCmd *cobra.Command, client cloudflare.CloudflareClient) error {
 	cmd := &cobra.Command{
 		Use:   "delete",
-		Short: "Delete a DNS record",
-		Run: func(cmd *cobra.Command, args []string) {
+		Short: "Delete DNS records",
+		Long: "Deletes the record selected by --id, or the records matching the filters. " +
+			"When several records match, --all is required to delete all of them.",
+		RunE: func(cmd *cobra.Command, args []string) error {
 			domain := cmd.Flag(constants.FlagDomain).Value.String()
-			zone, err := client.GetZoneByDomain(cmd.Context(), cloudflare.GetZoneByDomainRequest{
-				Domain: domain,
-			})
+			zoneID, err := zoneid.Acquire(cmd, client, domain)
 			if err != nil {
-				cmd.PrintErr(messages.ErrorMessage(err))
-				return
+				return err
 			}
 
-			recordID := cmd.Flag(constants.FlagID).Value.String()
-			if recordID == "" {
-				if cmd.Flag(constants.FlagName).Changed == false || cmd.Flag(constants.FlagType).Changed == false {
-					cmd.PrintErr(messages.ErrorMessage(fmt.Errorf("either --id OR (--name AND --type) must be specified")))
-					return
-				}
-
-				name := acquireEntryFullName(domain, cmd.Flag(constants.FlagName).Value.String())
-				zoneType, err := cloudflare.ParseZoneType(cmd.Flag(constants.FlagType).Value.String())
-				if err != nil {
-					cmd.PrintErr(messages.ErrorMessage(err))
-					return
-				}
-
-				records, err := client.GetZoneRecords(cmd.Context(), cloudflare.GetZoneRecordsRequest{
-					ZoneID: zone.ZoneID,
-					Name:   name,
-					Type:   zoneType,
-				})
-				if err != nil {
-					cmd.PrintErr(messages.ErrorMessage(err))
-					return
-				}
-
-				if len(records.Records) == 0 {
-					cmd.PrintErr(messages.ErrorMessage(fmt.Errorf("No records found")))
-					return
-				}
-
-				if len(records.Records) > 1 {
-					cmd.Print(messages.WarningMessage("Multiple records found, please specify the record utilizing the --id flag"))
-					t := table.NewWriter()
-					t.SetOutputMirror(cmd.OutOrStdout())
-					t.AppendHeader(table.Row{"ID", "Type", "Name", "Content", "Proxied", "TTL", "Tags", "Comment"})
-					for _, record := range records.Records {
-						t.AppendRow(table.Row{
-							record.ID,
-							record.Type,
-							record.Name,
-							record.Content,
-							record.Proxied,
-							record.TTL,
-							record.Tags,
-							record.Comment,
-						})
-					}
-
-					t.Render()
-					cmd.Print(messages.WarningMessage("Please specify the record utilizing the --id flag"))
-					return
-				}
-
-				recordID = records.Records[0].ID
-			}
-
-			err = client.DeleteZoneRecord(cmd.Context(), cloudflare.DeleteZoneRecordRequest{
-				ZoneID:   zone.ZoneID,
-				RecordID: recordID,
-			})
+			records, err := acquireDeleteRecords(cmd, client, domain, zoneID)
 			if err != nil {
-				cmd.PrintErr(messages.ErrorMessage(err))
-				return
+				return err
 			}
 
-			cmd.Print(messages.SuccessMessage(fmt.Sprintf("Record %s deleted", recordID)))
+			if len(records) == 1 {
+				return deleteRecord(cmd, client, zoneID, records[0])
+			}
+			return deleteRecords(cmd, client, zoneID, records)
 		},
 	}
 
-	cmd.Flags().String(constants.FlagName, "", "The name of the record to delete")
-	cmd.Flags().String(constants.FlagType, "", "The type of the record to delete")
 	cmd.Flags().String(constants.FlagID, "", "The ID of the record to delete")
+	cmd.Flags().String(constants.FlagName, "", "The name of the records to delete")
+	cmd.Flags().String(constants.FlagType, "", "The type of the records to delete")
+	cmd.Flags().String(constants.FlagContent, "", "The content of the records to delete")
+	cmd.Flags().StringSlice(constants.FlagTags, []string{}, "Tags the records to delete must all have, eg. env:old")
+	cmd.Flags().String(constants.FlagComment, "", "Regular expression the comment of the records to delete must match")
+	cmd.Flags().Bool(constants.FlagAll, false, "Delete every record matching the filters, instead of failing when several match")
+	cmd.Flags().Int(constants.FlagConcurrency, bulk.DefaultConcurrency, "How many records are deleted at the same time with --all")
+
+	for _, filter := range []string{constants.FlagName, constants.FlagType, constants.FlagContent, constants.FlagTags, constants.FlagComment, constants.FlagAll} {
+		cmd.MarkFlagsMutuallyExclusive(constants.FlagID, filter)
+	}
+
 	rootCmd.AddCommand(cmd)
+	return nil
+}
+
+// acquireDeleteRecords resolves the record selected by --id, or the records matching the filters.
+// When several records match without --all they are printed so the user can pick one with --id.
+func acquireDeleteRecords(cmd *cobra.Command, client cloudflare.CloudflareClient, domain, zoneID string) ([]cloudflare.ZoneRecord, error) {
+	if recordID := cmd.Flag(constants.FlagID).Value.String(); recordID != "" {
+		response, err := client.GetZoneRecord(cmd.Context(), cloudflare.GetZoneRecordRequest{
+			ZoneID:   zoneID,
+			RecordID: recordID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return []cloudflare.ZoneRecord{response.Record}, nil
+	}
+
+	filter, err := acquireDeleteFilter(cmd, domain)
+	if err != nil {
+		return nil, err
+	}
+	if filter.Empty() {
+		return nil, exitcode.Usagef("either --%s or at least one of --%s, --%s, --%s, --%s and --%s must be specified",
+			constants.FlagID, constants.FlagName, constants.FlagType, constants.FlagContent, constants.FlagTags, constants.FlagComment)
+	}
+
+	records, err := client.GetZoneRecords(cmd.Context(), filter.Request(zoneID))
+	if err != nil {
+		return nil, err
+	}
+	selected := filter.Select(records.Records)
+	if len(selected) == 0 {
+		return nil, fmt.Errorf("%w: no records match the filters", cloudflare.ErrRecordNotFound)
+	}
+
+	all, err := cmd.Flags().GetBool(constants.FlagAll)
+	if err != nil {
+		return nil, err
+	}
+	if len(selected) > 1 && !all {
+		cmd.Print(messages.WarningMessage("Multiple records found, please specify the record utilizing the --id flag, or pass --all to delete all of them"))
+		if err := printRecords(cmd, selected); err != nil {
+			return nil, err
+		}
+		return nil, exitcode.Usagef("%d records match, please specify the record utilizing the --id flag or pass --all", len(selected))
+	}
+
+	return selected, nil
+}
+
+func acquireDeleteFilter(cmd *cobra.Command, domain string) (bulk.Filter, error) {
+	var filter bulk.Filter
+	if cmd.Flag(constants.FlagName).Changed {
+		filter.Name = acquireEntryFullName(domain, cmd.Flag(constants.FlagName).Value.String())
+	}
+	if cmd.Flag(constants.FlagType).Changed {
+		zoneType, err := cloudflare.ParseZoneType(cmd.Flag(constants.FlagType).Value.String())
+		if err != nil {
+			return filter, err
+		}
+		filter.Type = zoneType
+	}
+	filter.Content = cmd.Flag(constants.FlagContent).Value.String()
+
+	tags, err := cmd.Flags().GetStringSlice(constants.FlagTags)
+	if err != nil {
+		return filter, err
+	}
+	filter.Tags = tags
+
+	if cmd.Flag(constants.FlagComment).Changed {
+		comment, err := regexp.Compile(cmd.Flag(constants.FlagComment).Value.String())
+		if err != nil {
+			return filter, exitcode.Usagef("invalid --%s expression: %s", constants.FlagComment, err.Error())
+		}
+		filter.Comment = comment
+	}
+	return filter, nil
+}
 
+func deleteRecord(cmd *cobra.Command, client cloudflare.CloudflareClient, zoneID string, record cloudflare.ZoneRecord) error {
+	err := prompt.Confirm(cmd, fmt.Sprintf("Delete %s record %s?", record.Type, record.Name), func() error {
+		return printRecord(cmd, record)
+	})
+	if err != nil {
+		return err
+	}
+
+	err = client.DeleteZoneRecord(cmd.Context(), cloudflare.DeleteZoneRecordRequest{
+		ZoneID:   zoneID,
+		RecordID: record.ID,
+	})
+	if err != nil {
+		return err
+	}
+
+	cmd.Print(messages.SuccessMessage(fmt.Sprintf("Record %s deleted", record.ID)))
+	return nil
+}
+
+func deleteRecords(cmd *cobra.Command, client cloudflare.CloudflareClient, zoneID string, records []cloudflare.ZoneRecord) error {
+	concurrency, err := cmd.Flags().GetInt(constants.FlagConcurrency)
+	if err != nil {
+		return err
+	}
+	if concurrency < 1 {
+		return exitcode.Usagef("--%s must be at least 1", constants.FlagConcurrency)
+	}
+
+	err = prompt.Confirm(cmd, fmt.Sprintf("Delete these %d records?", len(records)), func() error {
+		return printRecords(cmd, records)
+	})
+	if err != nil {
+		return err
+	}
+
+	results := bulk.Delete(cmd.Context(), client, zoneID, records, concurrency)
+
+	t := output.Table{
+		Header: table.Row{"ID", "Type", "Name", "Content", "Status", "Message"},
+	}
+	for _, result := range results {
+		status := deleteStatusDeleted
+		if result.Err != nil {
+			status = deleteStatusFailed
+		}
+		t.Rows = append(t.Rows, table.Row{
+			result.Record.ID,
+			result.Record.Type,
+			result.Record.Name,
+			result.Record.Content,
+			status,
+			result.Error,
+		})
+	}
+	if err := output.Print(cmd, results, t); err != nil {
+		return err
+	}
+
+	failed := bulk.Failed(results)
+	summary := fmt.Sprintf("Delete finished: %d records deleted, %d failed", len(results)-len(failed), len(failed))
+	if len(failed) > 0 {
+		// The first failure decides the exit code, eg. rate limited or unauthorized.
+		return fmt.Errorf("%s: %w", summary, failed[0].Err)
+	}
+	cmd.Print(messages.SuccessMessage(summary))
 	return nil
 }
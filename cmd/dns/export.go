@@ -0,0 +1,66 @@
+package dns
+
+import (
+	"fmt"
+	"github.com/jorgejr568/cloudflare-cli/cmd/messages"
+	"github.com/jorgejr568/cloudflare-cli/cmd/zoneid"
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+	"github.com/jorgejr568/cloudflare-cli/internal/constants"
+	"github.com/jorgejr568/cloudflare-cli/internal/zonefile"
+	"github.com/spf13/cobra"
+	"os"
+)
+
+func cmdDnsExport(rootCmd *cobra.Command, client cloudflare.CloudflareClient) error {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export all DNS records as a BIND zone file",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			domain := cmd.Flag(constants.FlagDomain).Value.String()
+			defaultTTL, err := cmd.Flags().GetInt(constants.FlagDefaultTTL)
+			if err != nil {
+				return err
+			}
+
+			zoneID, err := zoneid.Acquire(cmd, client, domain)
+			if err != nil {
+				return err
+			}
+
+			records, err := client.GetZoneRecords(cmd.Context(), cloudflare.GetZoneRecordsRequest{
+				ZoneID: zoneID,
+			})
+			if err != nil {
+				return err
+			}
+
+			path := cmd.Flag(constants.FlagFile).Value.String()
+			if path == "" {
+				return zonefile.Write(cmd.OutOrStdout(), domain, defaultTTL, records.Records)
+			}
+
+			file, err := os.Create(path)
+			if err != nil {
+				return err
+			}
+
+			if err := zonefile.Write(file, domain, defaultTTL, records.Records); err != nil {
+				file.Close()
+				return err
+			}
+
+			if err := file.Close(); err != nil {
+				return err
+			}
+
+			cmd.Print(messages.SuccessMessage(fmt.Sprintf("%d records exported to %s", len(records.Records), path)))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP(constants.FlagFile, "f", "", "Write the zone file to this path instead of stdout")
+	cmd.Flags().Int(constants.FlagDefaultTTL, 300, "Value of the $TTL directive, used by records with automatic TTL")
+
+	rootCmd.AddCommand(cmd)
+	return nil
+}
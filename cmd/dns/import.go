@@ -0,0 +1,209 @@
+package dns
+
+import (
+	"errors"
+	"fmt"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jorgejr568/cloudflare-cli/cmd/messages"
+	"github.com/jorgejr568/cloudflare-cli/cmd/output"
+	"github.com/jorgejr568/cloudflare-cli/cmd/zoneid"
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+	"github.com/jorgejr568/cloudflare-cli/internal/constants"
+	"github.com/jorgejr568/cloudflare-cli/internal/zonefile"
+	"github.com/spf13/cobra"
+	"strings"
+)
+
+type importStatus string
+
+const (
+	importStatusCreated importStatus = "created"
+	importStatusSkipped importStatus = "skipped"
+	importStatusFailed  importStatus = "failed"
+)
+
+type importResult struct {
+	Name    string                 `json:"name"`
+	Type    string                 `json:"type"`
+	Content string                 `json:"content"`
+	Status  importStatus           `json:"status"`
+	Message string                 `json:"message,omitempty"`
+	Record  *cloudflare.ZoneRecord `json:"record,omitempty"`
+}
+
+func cmdDnsImport(rootCmd *cobra.Command, client cloudflare.CloudflareClient) error {
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import DNS records from a BIND zone file",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			domain := cmd.Flag(constants.FlagDomain).Value.String()
+			origin := cmd.Flag(constants.FlagOrigin).Value.String()
+			if origin == "" {
+				origin = domain
+			}
+
+			skipExisting, err := cmd.Flags().GetBool(constants.FlagSkipExisting)
+			if err != nil {
+				return err
+			}
+			proxied, err := cmd.Flags().GetBool(constants.FlagProxied)
+			if err != nil {
+				return err
+			}
+			tags, err := cmd.Flags().GetStringSlice(constants.FlagTags)
+			if err != nil {
+				return err
+			}
+			comment := cmd.Flag(constants.FlagComment).Value.String()
+
+			records, err := zonefile.ParseFile(cmd.Flag(constants.FlagFile).Value.String(), origin)
+			if err != nil {
+				return err
+			}
+
+			zoneID, err := zoneid.Acquire(cmd, client, domain)
+			if err != nil {
+				return err
+			}
+
+			current, err := client.GetZoneRecords(cmd.Context(), cloudflare.GetZoneRecordsRequest{
+				ZoneID: zoneID,
+			})
+			if err != nil {
+				return err
+			}
+			zoneRecords := current.Records
+			existing := map[string]bool{}
+			if skipExisting {
+				for _, record := range zoneRecords {
+					existing[cloudflare.RecordIdentity(record.Name, record.Type, record.Content, record.Data)] = true
+				}
+			}
+
+			var results []importResult
+			// failure keeps the first error so the exit code reflects why the import did not complete.
+			var failure error
+			for _, record := range records {
+				result := importResult{
+					Name:    record.Name,
+					Type:    record.Type,
+					Content: strings.Join(record.RData, " "),
+				}
+
+				if reason := managedByCloudflare(domain, record); reason != "" {
+					result.Status = importStatusSkipped
+					result.Message = reason
+					results = append(results, result)
+					continue
+				}
+
+				request, err := record.ZoneRecordRequest()
+				if err != nil {
+					result.Status = importStatusFailed
+					if errors.Is(err, zonefile.ErrUnsupportedRecord) {
+						result.Status = importStatusSkipped
+					} else if failure == nil {
+						failure = err
+					}
+					result.Message = err.Error()
+					results = append(results, result)
+					continue
+				}
+				result.Content = request.Content
+				if request.Data != nil {
+					result.Content = request.Data.String()
+				}
+
+				if existing[cloudflare.RecordIdentity(request.Name, request.Type, request.Content, request.Data)] {
+					result.Status = importStatusSkipped
+					result.Message = "record already exists"
+					results = append(results, result)
+					continue
+				}
+
+				request.Proxied = proxied && request.Type.IsProxiable()
+				request.Tags = tags
+				request.Comment = comment
+
+				if err := cloudflare.ValidateZoneRecord(domain, request, zoneRecords); err != nil {
+					if failure == nil {
+						failure = err
+					}
+					result.Status = importStatusFailed
+					result.Message = err.Error()
+					results = append(results, result)
+					continue
+				}
+
+				response, err := client.AddZoneRecord(cmd.Context(), cloudflare.AddZoneRecordRequest{
+					ZoneID: zoneID,
+					Record: request,
+				})
+				if err != nil {
+					if failure == nil {
+						failure = err
+					}
+					result.Status = importStatusFailed
+					result.Message = err.Error()
+					results = append(results, result)
+					continue
+				}
+
+				existing[cloudflare.RecordIdentity(request.Name, request.Type, request.Content, request.Data)] = true
+				zoneRecords = append(zoneRecords, response.Record)
+				result.Status = importStatusCreated
+				result.Record = &response.Record
+				results = append(results, result)
+			}
+
+			t := output.Table{
+				Header: table.Row{"Name", "Type", "Content", "Status", "Message"},
+			}
+			counts := map[importStatus]int{}
+			for _, result := range results {
+				counts[result.Status]++
+				t.Rows = append(t.Rows, table.Row{
+					result.Name,
+					result.Type,
+					result.Content,
+					result.Status,
+					result.Message,
+				})
+			}
+			if err := output.Print(cmd, results, t); err != nil {
+				return err
+			}
+
+			summary := fmt.Sprintf("Import finished: %d created, %d skipped, %d failed",
+				counts[importStatusCreated], counts[importStatusSkipped], counts[importStatusFailed])
+			if failure != nil {
+				return fmt.Errorf("%s: %w", summary, failure)
+			}
+			cmd.Print(messages.SuccessMessage(summary))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP(constants.FlagFile, "f", "", "Path to the BIND zone file")
+	cmd.Flags().String(constants.FlagOrigin, "", "Origin used for relative names until the file sets $ORIGIN (defaults to --domain)")
+	cmd.Flags().Bool(constants.FlagSkipExisting, false, "Skip records that already exist with the same name, type and content")
+	cmd.Flags().Bool(constants.FlagProxied, false, "Proxy imported A, AAAA and CNAME records")
+	cmd.Flags().StringSlice(constants.FlagTags, []string{}, "Tags of the imported records")
+	cmd.Flags().String(constants.FlagComment, "", "Comment of the imported records")
+
+	cmd.MarkFlagRequired(constants.FlagFile)
+
+	rootCmd.AddCommand(cmd)
+	return nil
+}
+
+// managedByCloudflare explains why a record is skipped because Cloudflare already serves it for the zone.
+func managedByCloudflare(domain string, record zonefile.Record) string {
+	if record.Type == string(cloudflare.ZoneTypeSOA) {
+		return "SOA is managed by Cloudflare"
+	}
+	if record.Type == string(cloudflare.ZoneTypeNS) && strings.EqualFold(record.Name, domain) {
+		return "apex NS records are managed by Cloudflare"
+	}
+	return ""
+}
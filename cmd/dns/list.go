@@ -1,8 +1,7 @@
 package dns
 
 import (
-	"github.com/jedib0t/go-pretty/v6/table"
-	"github.com/jorgejr568/cloudflare-cli/cmd/messages"
+	"github.com/jorgejr568/cloudflare-cli/cmd/zoneid"
 	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
 	"github.com/jorgejr568/cloudflare-cli/internal/constants"
 	"github.com/spf13/cobra"
@@ -12,14 +11,11 @@ func cmdDnsList(rootCmd *cobra.Command, client cloudflare.CloudflareClient) erro
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List all DNS records",
-		Run: func(cmd *cobra.Command, args []string) {
+		RunE: func(cmd *cobra.Command, args []string) error {
 			domain := cmd.Flag(constants.FlagDomain).Value.String()
-			zone, err := client.GetZoneByDomain(cmd.Context(), cloudflare.GetZoneByDomainRequest{
-				Domain: domain,
-			})
+			zoneID, err := zoneid.Acquire(cmd, client, domain)
 			if err != nil {
-				cmd.PrintErr(messages.ErrorMessage(err))
-				return
+				return err
 			}
 
 			name := cmd.Flag(constants.FlagName).Value.String()
@@ -30,37 +26,20 @@ func cmdDnsList(rootCmd *cobra.Command, client cloudflare.CloudflareClient) erro
 			if cmd.Flag(constants.FlagType).Changed {
 				zoneType, err = cloudflare.ParseZoneType(cmd.Flag(constants.FlagType).Value.String())
 				if err != nil {
-					cmd.PrintErr(messages.ErrorMessage(err))
-					return
+					return err
 				}
 			}
 
 			records, err := client.GetZoneRecords(cmd.Context(), cloudflare.GetZoneRecordsRequest{
-				ZoneID: zone.ZoneID,
+				ZoneID: zoneID,
 				Name:   name,
 				Type:   zoneType,
 			})
 			if err != nil {
-				cmd.PrintErr(messages.ErrorMessage(err))
-				return
+				return err
 			}
 
-			t := table.NewWriter()
-			t.SetOutputMirror(cmd.OutOrStdout())
-			t.AppendHeader(table.Row{"ID", "Type", "Name", "Content", "Proxied", "TTL", "Tags", "Comment"})
-			for _, record := range records.Records {
-				t.AppendRow(table.Row{
-					record.ID,
-					record.Type,
-					record.Name,
-					record.Content,
-					record.Proxied,
-					record.TTL,
-					record.Tags,
-					record.Comment,
-				})
-			}
-			t.Render()
+			return printRecords(cmd, records.Records)
 		},
 	}
 
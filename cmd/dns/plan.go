@@ -0,0 +1,121 @@
+package dns
+
+import (
+	"fmt"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jorgejr568/cloudflare-cli/cmd/exitcode"
+	"github.com/jorgejr568/cloudflare-cli/cmd/messages"
+	"github.com/jorgejr568/cloudflare-cli/cmd/output"
+	"github.com/jorgejr568/cloudflare-cli/cmd/zoneid"
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+	"github.com/jorgejr568/cloudflare-cli/internal/constants"
+	"github.com/jorgejr568/cloudflare-cli/internal/reconcile"
+	"github.com/spf13/cobra"
+	"strings"
+)
+
+func cmdDnsPlan(rootCmd *cobra.Command, client cloudflare.CloudflareClient) error {
+	cmd := &cobra.Command{
+		Use:   "plan",
+		Short: "Show the changes needed to reconcile a zone with a desired-state file",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			_, plan, err := acquirePlan(cmd, client)
+			if err != nil {
+				return err
+			}
+
+			if err := printPlan(cmd, plan); err != nil {
+				return err
+			}
+			if plan.HasChanges() {
+				exitCode, err := cmd.Flags().GetBool(constants.FlagExitCode)
+				if err != nil {
+					return err
+				}
+				if exitCode {
+					return &exitcode.Error{Code: exitcode.PlanHasChanges}
+				}
+			}
+			return nil
+		},
+	}
+
+	addPlanFlags(cmd)
+	cmd.Flags().Bool(constants.FlagExitCode, false, fmt.Sprintf("Exit with status %d when the plan has changes", exitcode.PlanHasChanges))
+
+	rootCmd.AddCommand(cmd)
+	return nil
+}
+
+func addPlanFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP(constants.FlagFile, "f", "", "Path to the YAML or JSON desired-state file")
+	cmd.Flags().Bool(constants.FlagPurge, false, "Delete records that are not in the desired-state file (overrides purge_unmanaged)")
+	cmd.MarkFlagRequired(constants.FlagFile)
+}
+
+// acquirePlan loads the desired-state file and diffs it against the live records of the zone.
+func acquirePlan(cmd *cobra.Command, client cloudflare.CloudflareClient) (string, reconcile.Plan, error) {
+	domain := cmd.Flag(constants.FlagDomain).Value.String()
+	state, err := reconcile.LoadDesiredState(cmd.Flag(constants.FlagFile).Value.String())
+	if err != nil {
+		return "", reconcile.Plan{}, err
+	}
+
+	purge := state.PurgeUnmanaged
+	if cmd.Flag(constants.FlagPurge).Changed {
+		purge, err = cmd.Flags().GetBool(constants.FlagPurge)
+		if err != nil {
+			return "", reconcile.Plan{}, err
+		}
+	}
+
+	zoneID, err := zoneid.Acquire(cmd, client, domain)
+	if err != nil {
+		return "", reconcile.Plan{}, err
+	}
+
+	records, err := client.GetZoneRecords(cmd.Context(), cloudflare.GetZoneRecordsRequest{
+		ZoneID: zoneID,
+	})
+	if err != nil {
+		return "", reconcile.Plan{}, err
+	}
+
+	plan, err := reconcile.ComputePlan(domain, state, records.Records, purge)
+	if err != nil {
+		return "", reconcile.Plan{}, err
+	}
+
+	return zoneID, plan, nil
+}
+
+func printPlan(cmd *cobra.Command, plan reconcile.Plan) error {
+	if !plan.HasChanges() {
+		cmd.Print(messages.SuccessMessage(fmt.Sprintf("No changes. The zone matches the desired state (%d unmanaged records)", plan.Unmanaged)))
+		return nil
+	}
+
+	t := output.Table{
+		Header: table.Row{"Action", "Type", "Name", "Content", "Details"},
+	}
+	for _, change := range plan.Changes {
+		t.Rows = append(t.Rows, table.Row{
+			change.Action,
+			change.Type(),
+			change.Name(),
+			change.Content(),
+			strings.Join(change.Diff, "; "),
+		})
+	}
+	if err := output.Print(cmd, plan, t); err != nil {
+		return err
+	}
+
+	cmd.Print(messages.WarningMessage(fmt.Sprintf("Plan: %d to create, %d to update, %d to delete (%d unmanaged records)",
+		plan.Count(reconcile.ActionCreate),
+		plan.Count(reconcile.ActionUpdate),
+		plan.Count(reconcile.ActionDelete),
+		plan.Unmanaged,
+	)))
+	return nil
+}
@@ -1,6 +1,7 @@
 package dns
 
 import (
+	"github.com/jorgejr568/cloudflare-cli/cmd/zoneid"
 	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
 	"github.com/jorgejr568/cloudflare-cli/internal/constants"
 	"github.com/spf13/cobra"
@@ -12,6 +13,7 @@ func CmdDns(rootCmd *cobra.Command, client cloudflare.CloudflareClient) error {
 		Short: "Manage DNS records",
 	}
 	cmd.PersistentFlags().StringP(constants.FlagDomain, "d", "", "The domain to list DNS records for")
+	zoneid.AddFlag(cmd.PersistentFlags())
 	cmd.MarkPersistentFlagRequired(constants.FlagDomain)
 	err := cmdDnsList(cmd, client)
 	if err != nil {
@@ -23,10 +25,39 @@ func CmdDns(rootCmd *cobra.Command, client cloudflare.CloudflareClient) error {
 		return err
 	}
 
+	err = cmdDnsUpdate(cmd, client)
+	if err != nil {
+		return err
+	}
+
 	err = cmdDnsDelete(cmd, client)
 	if err != nil {
 		return err
 	}
+	err = cmdDnsExport(cmd, client)
+	if err != nil {
+		return err
+	}
+
+	err = cmdDnsImport(cmd, client)
+	if err != nil {
+		return err
+	}
+
+	err = cmdDnsPlan(cmd, client)
+	if err != nil {
+		return err
+	}
+
+	err = cmdDnsApply(cmd, client)
+	if err != nil {
+		return err
+	}
+
+	err = cmdDnsDdns(cmd, client)
+	if err != nil {
+		return err
+	}
 	rootCmd.AddCommand(cmd)
 	return nil
 }
@@ -0,0 +1,164 @@
+package dns
+
+import (
+	"fmt"
+	"github.com/jorgejr568/cloudflare-cli/cmd/exitcode"
+	"github.com/jorgejr568/cloudflare-cli/cmd/zoneid"
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+	"github.com/jorgejr568/cloudflare-cli/internal/constants"
+	"github.com/spf13/cobra"
+)
+
+func cmdDnsUpdate(rootCmd *cobra.Command, client cloudflare.CloudflareClient) error {
+	cmd := &cobra.Command{
+		Use:   "update",
+		Short: "Update a DNS record",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			domain := cmd.Flag(constants.FlagDomain).Value.String()
+
+			var patch cloudflare.ZoneRecordPatch
+			changed := false
+			if cmd.Flag(constants.FlagNewName).Changed {
+				name := acquireEntryFullName(domain, cmd.Flag(constants.FlagNewName).Value.String())
+				patch.Name = &name
+				changed = true
+			}
+			if cmd.Flag(constants.FlagContent).Changed {
+				content := cmd.Flag(constants.FlagContent).Value.String()
+				patch.Content = &content
+				changed = true
+			}
+			if cmd.Flag(constants.FlagData).Changed {
+				if !cmd.Flag(constants.FlagType).Changed {
+					return exitcode.Usagef("--%s must be specified with --%s to validate the data fields", constants.FlagType, constants.FlagData)
+				}
+				zoneType, err := cloudflare.ParseZoneType(cmd.Flag(constants.FlagType).Value.String())
+				if err != nil {
+					return err
+				}
+				patch.Data, err = acquireRecordData(cmd, zoneType)
+				if err != nil {
+					return err
+				}
+				changed = true
+			}
+			var priority *int
+			if cmd.Flag(constants.FlagPriority).Changed {
+				value, err := cmd.Flags().GetInt(constants.FlagPriority)
+				if err != nil {
+					return err
+				}
+				priority = &value
+				changed = true
+			}
+			if cmd.Flag(constants.FlagTTL).Changed {
+				ttl, err := cmd.Flags().GetInt(constants.FlagTTL)
+				if err != nil {
+					return err
+				}
+				if ttl < 1 || ttl > 86400 {
+					return fmt.Errorf("%w: TTL must be between 1 and 86400", cloudflare.ErrInvalidRecord)
+				}
+				patch.TTL = &ttl
+				changed = true
+			}
+			if cmd.Flag(constants.FlagProxied).Changed {
+				proxied, err := cmd.Flags().GetBool(constants.FlagProxied)
+				if err != nil {
+					return err
+				}
+				patch.Proxied = &proxied
+				changed = true
+			}
+			if cmd.Flag(constants.FlagTags).Changed {
+				tags, err := cmd.Flags().GetStringSlice(constants.FlagTags)
+				if err != nil {
+					return err
+				}
+				patch.Tags = &tags
+				changed = true
+			}
+			if cmd.Flag(constants.FlagComment).Changed {
+				comment := cmd.Flag(constants.FlagComment).Value.String()
+				patch.Comment = &comment
+				changed = true
+			}
+
+			if !changed {
+				return exitcode.Usagef("at least one of --%s, --%s, --%s, --%s, --%s, --%s, --%s or --%s must be specified",
+					constants.FlagNewName, constants.FlagContent, constants.FlagData, constants.FlagPriority, constants.FlagTTL, constants.FlagProxied, constants.FlagTags, constants.FlagComment)
+			}
+
+			zoneID, err := zoneid.Acquire(cmd, client, domain)
+			if err != nil {
+				return err
+			}
+
+			current, err := acquireRecord(cmd, client, domain, zoneID)
+			if err != nil {
+				return err
+			}
+			if priority != nil {
+				applyPriority(&patch, current, *priority)
+			}
+
+			// Only records sharing the resulting name matter for the CNAME coexistence check.
+			desired := patch.ApplyTo(current)
+			existing, err := client.GetZoneRecords(cmd.Context(), cloudflare.GetZoneRecordsRequest{
+				ZoneID: zoneID,
+				Name:   desired.Name,
+			})
+			if err != nil {
+				return err
+			}
+			if err := cloudflare.ValidateZoneRecord(domain, desired, existing.Records); err != nil {
+				return err
+			}
+
+			response, err := client.PatchZoneRecord(cmd.Context(), cloudflare.PatchZoneRecordRequest{
+				ZoneID:   zoneID,
+				RecordID: current.ID,
+				Record:   patch,
+			})
+			if err != nil {
+				return err
+			}
+
+			return printRecord(cmd, response.Record)
+		},
+	}
+
+	cmd.Flags().String(constants.FlagID, "", "The ID of the record to update")
+	cmd.Flags().String(constants.FlagName, "", "The name of the record to update")
+	cmd.Flags().String(constants.FlagType, "", "The type of the record to update")
+	cmd.Flags().String(constants.FlagNewName, "", "New name of the record")
+	cmd.Flags().String(constants.FlagContent, "", "New content of the record")
+	cmd.Flags().StringArray(constants.FlagData, []string{}, "New structured data field of the record as key=value. Repeat for each field, requires --type")
+	cmd.Flags().Int(constants.FlagPriority, 0, "New priority of MX, SRV and URI records (0-65535)")
+	cmd.Flags().Int(constants.FlagTTL, 1, "New TTL of the record (1-86400)")
+	cmd.Flags().Bool(constants.FlagProxied, false, "New proxied status of the record")
+	cmd.Flags().StringSlice(constants.FlagTags, []string{}, "New tags of the record")
+	cmd.Flags().String(constants.FlagComment, "", "New comment of the record")
+
+	rootCmd.AddCommand(cmd)
+	return nil
+}
+
+// applyPriority sets the priority on the patch, moving it into data for SRV records which keep it there.
+func applyPriority(patch *cloudflare.ZoneRecordPatch, current cloudflare.ZoneRecord, priority int) {
+	if patch.ApplyTo(current).Type != cloudflare.ZoneTypeSRV {
+		patch.Priority = &priority
+		return
+	}
+
+	data := cloudflare.ZoneRecordData{}
+	source := patch.Data
+	if source == nil {
+		source = current.Data
+	}
+	for key, value := range source {
+		data[key] = value
+	}
+	data["priority"] = priority
+	patch.Data = data
+}
@@ -1,5 +1,17 @@
 package dns
 
+import (
+	"fmt"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jorgejr568/cloudflare-cli/cmd/exitcode"
+	"github.com/jorgejr568/cloudflare-cli/cmd/messages"
+	"github.com/jorgejr568/cloudflare-cli/cmd/output"
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+	"github.com/jorgejr568/cloudflare-cli/internal/constants"
+	"github.com/spf13/cobra"
+	"strings"
+)
+
 func acquireEntryFullName(domain, entry string) string {
 	if entry == "@" || entry == "" {
 		return domain
@@ -7,3 +19,114 @@ func acquireEntryFullName(domain, entry string) string {
 
 	return entry + "." + domain
 }
+
+// acquireRecordData parses the --data flag for records of the given type, returning nil when it was not passed.
+func acquireRecordData(cmd *cobra.Command, zoneType cloudflare.ZoneType) (cloudflare.ZoneRecordData, error) {
+	pairs, err := cmd.Flags().GetStringArray(constants.FlagData)
+	if err != nil {
+		return nil, err
+	}
+
+	// SRV records keep their priority in data, so --priority is accepted as a shortcut for --data priority=N.
+	if zoneType == cloudflare.ZoneTypeSRV && cmd.Flag(constants.FlagPriority).Changed && len(pairs) > 0 {
+		pairs = append(pairs, constants.FlagPriority+"="+cmd.Flag(constants.FlagPriority).Value.String())
+	}
+
+	if len(pairs) == 0 {
+		if zoneType.RequiresData() {
+			return nil, exitcode.Usagef("--%s is required for %s records, expected fields: %s", constants.FlagData, zoneType, strings.Join(zoneType.DataFields(), ", "))
+		}
+		return nil, nil
+	}
+
+	return cloudflare.ParseZoneRecordData(zoneType, pairs)
+}
+
+// acquirePriority returns the --priority flag for types that keep it outside of data, or nil when it was not passed.
+func acquirePriority(cmd *cobra.Command, zoneType cloudflare.ZoneType) (*int, error) {
+	if !cmd.Flag(constants.FlagPriority).Changed || zoneType == cloudflare.ZoneTypeSRV {
+		return nil, nil
+	}
+
+	priority, err := cmd.Flags().GetInt(constants.FlagPriority)
+	if err != nil {
+		return nil, err
+	}
+	return &priority, nil
+}
+
+func recordsTable(records []cloudflare.ZoneRecord) output.Table {
+	t := output.Table{
+		Header: table.Row{"ID", "Type", "Name", "Content", "Priority", "Proxied", "TTL", "Tags", "Comment"},
+	}
+	for _, record := range records {
+		t.Rows = append(t.Rows, table.Row{
+			record.ID,
+			record.Type,
+			record.Name,
+			record.Content,
+			record.DisplayPriority(),
+			record.Proxied,
+			record.TTL,
+			record.Tags,
+			record.Comment,
+		})
+	}
+	return t
+}
+
+func printRecords(cmd *cobra.Command, records []cloudflare.ZoneRecord) error {
+	return output.Print(cmd, records, recordsTable(records))
+}
+
+func printRecord(cmd *cobra.Command, record cloudflare.ZoneRecord) error {
+	return output.Print(cmd, record, recordsTable([]cloudflare.ZoneRecord{record}))
+}
+
+// acquireRecord resolves the record selected by --id or by --name and --type.
+// When several records match they are printed so the user can pick one with --id.
+func acquireRecord(cmd *cobra.Command, client cloudflare.CloudflareClient, domain, zoneID string) (cloudflare.ZoneRecord, error) {
+	if recordID := cmd.Flag(constants.FlagID).Value.String(); recordID != "" {
+		response, err := client.GetZoneRecord(cmd.Context(), cloudflare.GetZoneRecordRequest{
+			ZoneID:   zoneID,
+			RecordID: recordID,
+		})
+		if err != nil {
+			return cloudflare.ZoneRecord{}, err
+		}
+		return response.Record, nil
+	}
+
+	if cmd.Flag(constants.FlagName).Changed == false || cmd.Flag(constants.FlagType).Changed == false {
+		return cloudflare.ZoneRecord{}, exitcode.Usagef("either --id OR (--name AND --type) must be specified")
+	}
+
+	name := acquireEntryFullName(domain, cmd.Flag(constants.FlagName).Value.String())
+	zoneType, err := cloudflare.ParseZoneType(cmd.Flag(constants.FlagType).Value.String())
+	if err != nil {
+		return cloudflare.ZoneRecord{}, err
+	}
+
+	records, err := client.GetZoneRecords(cmd.Context(), cloudflare.GetZoneRecordsRequest{
+		ZoneID: zoneID,
+		Name:   name,
+		Type:   zoneType,
+	})
+	if err != nil {
+		return cloudflare.ZoneRecord{}, err
+	}
+
+	if len(records.Records) == 0 {
+		return cloudflare.ZoneRecord{}, fmt.Errorf("%w: no %s records named %s", cloudflare.ErrRecordNotFound, zoneType, name)
+	}
+
+	if len(records.Records) > 1 {
+		cmd.Print(messages.WarningMessage("Multiple records found, please specify the record utilizing the --id flag"))
+		if err := printRecords(cmd, records.Records); err != nil {
+			return cloudflare.ZoneRecord{}, err
+		}
+		return cloudflare.ZoneRecord{}, exitcode.Usagef("%d records match, please specify the record utilizing the --id flag", len(records.Records))
+	}
+
+	return records.Records[0], nil
+}
@@ -0,0 +1,115 @@
+package exitcode
+
+import (
+	"errors"
+	"fmt"
+	"github.com/jorgejr568/cloudflare-cli/cmd/output"
+	"github.com/jorgejr568/cloudflare-cli/cmd/prompt"
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+	"github.com/jorgejr568/cloudflare-cli/internal/config"
+	"github.com/jorgejr568/cloudflare-cli/internal/ddns"
+	"github.com/jorgejr568/cloudflare-cli/internal/reconcile"
+	"github.com/jorgejr568/cloudflare-cli/internal/zonefile"
+)
+
+// Process exit codes, documented in the README. They are part of the CLI contract, do not renumber them.
+const (
+	OK             = 0
+	General        = 1
+	PlanHasChanges = 2
+	NotFound       = 3
+	Unauthorized   = 4
+	Invalid        = 5
+	APIFailure     = 6
+	RateLimited    = 7
+	Usage          = 8
+)
+
+// ErrUsage marks errors caused by missing, conflicting or malformed flags and arguments.
+var ErrUsage = errors.New("invalid usage")
+
+// Error terminates the process with an explicit code. Without a wrapped error nothing is printed,
+// which is how outcomes that are not failures, such as a plan with changes, are reported.
+type Error struct {
+	Code int
+	Err  error
+}
+
+func (e *Error) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("exit status %d", e.Code)
+	}
+	return e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Usagef formats an error wrapping ErrUsage.
+func Usagef(format string, a ...interface{}) error {
+	return fmt.Errorf("%w: %s", ErrUsage, fmt.Sprintf(format, a...))
+}
+
+// Of maps an error returned by a command to the process exit code.
+func Of(err error) int {
+	if err == nil {
+		return OK
+	}
+
+	var exitErr *Error
+	if errors.As(err, &exitErr) {
+		return exitErr.Code
+	}
+
+	switch {
+	// Rate limiting and authentication failures wrap the API failure of the request, so they are checked first.
+	case errors.Is(err, cloudflare.ErrRateLimited):
+		return RateLimited
+	case errors.Is(err, cloudflare.ErrUnauthorized),
+		errors.Is(err, cloudflare.ErrForbidden):
+		return Unauthorized
+	case errors.Is(err, cloudflare.ErrZoneNotFound),
+		errors.Is(err, cloudflare.ErrRecordNotFound),
+		errors.Is(err, cloudflare.ErrTokenNotFound),
+		errors.Is(err, cloudflare.ErrSettingNotFound),
+		errors.Is(err, config.ErrProfileNotFound):
+		return NotFound
+	case errors.Is(err, cloudflare.ErrInvalidRecord),
+		errors.Is(err, cloudflare.InvalidZoneType),
+		errors.Is(err, cloudflare.InvalidZoneRecordData),
+		errors.Is(err, reconcile.ErrInvalidDesiredState),
+		errors.Is(err, zonefile.ErrSyntax),
+		errors.Is(err, zonefile.ErrIncludeTooDeep),
+		errors.Is(err, zonefile.ErrUnsupportedRecord),
+		errors.Is(err, ddns.ErrInvalidSource),
+		errors.Is(err, cloudflare.ErrInvalidAuthMethod),
+		errors.Is(err, cloudflare.ErrInvalidZoneSetup),
+		errors.Is(err, cloudflare.ErrInvalidZoneSetting):
+		return Invalid
+	case errors.Is(err, cloudflare.ErrZoneListFailed),
+		errors.Is(err, cloudflare.ErrZoneRecordsFailed),
+		errors.Is(err, cloudflare.ErrZoneDetailsFailed),
+		errors.Is(err, cloudflare.ErrZoneCreateFailed),
+		errors.Is(err, cloudflare.ErrZoneUpdateFailed),
+		errors.Is(err, cloudflare.ErrZoneDeleteFailed),
+		errors.Is(err, cloudflare.ErrActivationFailed),
+		errors.Is(err, cloudflare.ErrZoneSettingsFailed),
+		errors.Is(err, cloudflare.ErrSettingUpdateFailed),
+		errors.Is(err, cloudflare.ErrCachePurgeFailed),
+		errors.Is(err, cloudflare.ErrRecordAddFailed),
+		errors.Is(err, cloudflare.ErrRecordUpdateFailed),
+		errors.Is(err, cloudflare.ErrRecordDeleteFailed),
+		errors.Is(err, cloudflare.ErrTokenVerifyFailed),
+		errors.Is(err, cloudflare.ErrTokenDetailsFailed),
+		errors.Is(err, cloudflare.ErrUserDetailsFailed):
+		return APIFailure
+	case errors.Is(err, ErrUsage),
+		errors.Is(err, output.ErrInvalidFormat),
+		errors.Is(err, output.ErrMissingTemplate),
+		errors.Is(err, prompt.ErrNotInteractive):
+		return Usage
+	default:
+		return General
+	}
+}
@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+	"github.com/jorgejr568/cloudflare-cli/internal/constants"
+	"github.com/jorgejr568/cloudflare-cli/internal/tracing"
+	"github.com/jorgejr568/cloudflare-cli/internal/zonecache"
+	"github.com/spf13/pflag"
+	"io"
+	"math"
+	"net/http"
+	"os"
+	"time"
+)
+
+// defaultRateLimit keeps a single process within Cloudflare's limit of 1200 requests per 5 minutes.
+const defaultRateLimit = 4
+
+// globalFlags configure the dependencies handed to every command, so they are read from the arguments
+// before the command tree is built. The same flag set is registered on the root command, which parses it again
+// and reports any error.
+type globalFlags struct {
+	flagSet      *pflag.FlagSet
+	profile      string
+	retries      int
+	rateLimit    float64
+	zoneCacheTTL time.Duration
+	verbose      bool
+	traceFile    string
+	traceBodies  bool
+	dryRun       bool
+}
+
+func acquireGlobalFlags() (*globalFlags, error) {
+	flags := &globalFlags{
+		flagSet: pflag.NewFlagSet("global", pflag.ContinueOnError),
+	}
+	flags.flagSet.StringVar(&flags.profile, constants.FlagProfile, "", "Configuration profile to use (defaults to $CLOUDFLARE_PROFILE, then the current profile)")
+	flags.flagSet.IntVar(&flags.retries, constants.FlagRetries, cloudflare.DefaultRetryPolicy().MaxRetries, "Times a request is retried after a rate limit, a network error or a server error. POST requests are only retried when rate limited")
+	flags.flagSet.Float64Var(&flags.rateLimit, constants.FlagRateLimit, defaultRateLimit, "Maximum API requests per second, 0 disables the limit. Cloudflare allows 1200 requests per 5 minutes")
+	flags.flagSet.BoolP(constants.FlagYes, "y", false, "Confirm destructive actions without asking, required when stdin is not a terminal")
+	flags.flagSet.BoolVar(&flags.dryRun, constants.FlagDryRun, false, "Print the changes commands would send to the API instead of sending them, reads are still sent")
+	flags.flagSet.BoolVar(&flags.verbose, constants.FlagVerbose, false, "Log every API request and response to stderr, credentials are redacted")
+	flags.flagSet.StringVar(&flags.traceFile, constants.FlagTraceFile, "", "Append the log of every API request and response to this file instead of stderr")
+	flags.flagSet.BoolVar(&flags.traceBodies, constants.FlagTraceBodies, false, "Also log the request and response bodies with --verbose or --trace-file")
+	flags.flagSet.DurationVar(&flags.zoneCacheTTL, constants.FlagZoneCacheTTL, zonecache.DefaultTTL, "How long the zone ID of a domain is cached, 0 disables the cache")
+
+	// Command flags are unknown at this point and --help is handled by cobra, so parse errors are ignored here.
+	flags.flagSet.ParseErrorsWhitelist.UnknownFlags = true
+	flags.flagSet.SetOutput(io.Discard)
+	flags.flagSet.Usage = func() {}
+	_ = flags.flagSet.Parse(os.Args[1:])
+
+	return flags, nil
+}
+
+// clientOptions translates the retry and rate limit flags into options of the HTTP client.
+func (f *globalFlags) clientOptions() []cloudflare.Option {
+	policy := cloudflare.DefaultRetryPolicy()
+	policy.MaxRetries = max(f.retries, 0)
+
+	options := []cloudflare.Option{cloudflare.WithRetryPolicy(policy)}
+	if f.rateLimit > 0 {
+		options = append(options, cloudflare.WithRateLimiter(cloudflare.NewRateLimiter(f.rateLimit, int(math.Ceil(f.rateLimit)))))
+	}
+	return options
+}
+
+// traceLog is the file opened by --trace-file, closed once the command finished. It is nil without the flag.
+type traceLog io.Closer
+
+// httpClient builds the client sending the API requests, tracing them when --verbose or --trace-file is set.
+func (f *globalFlags) httpClient(credentials cloudflare.Credentials) (*http.Client, traceLog, error) {
+	if !f.verbose && f.traceFile == "" {
+		return &http.Client{}, nil, nil
+	}
+
+	var out io.Writer = os.Stderr
+	var log traceLog
+	if f.traceFile != "" {
+		file, err := os.OpenFile(f.traceFile, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+		if err != nil {
+			return nil, nil, err
+		}
+		out = file
+		log = file
+	}
+
+	options := []tracing.Option{tracing.WithSecrets(credentials.Secrets()...)}
+	if f.traceBodies {
+		options = append(options, tracing.WithBodies())
+	}
+	return &http.Client{
+		Transport: tracing.NewTransport(http.DefaultTransport, out, options...),
+	}, log, nil
+}
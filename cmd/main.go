@@ -1,38 +1,80 @@
 package cmd
 
 import (
+	"errors"
+	"github.com/jorgejr568/cloudflare-cli/cmd/auth"
+	"github.com/jorgejr568/cloudflare-cli/cmd/cache"
 	cmdconfig "github.com/jorgejr568/cloudflare-cli/cmd/config"
 	"github.com/jorgejr568/cloudflare-cli/cmd/dns"
+	"github.com/jorgejr568/cloudflare-cli/cmd/exitcode"
+	"github.com/jorgejr568/cloudflare-cli/cmd/messages"
+	"github.com/jorgejr568/cloudflare-cli/cmd/zones"
 	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
 	"github.com/jorgejr568/cloudflare-cli/internal/config"
 	"github.com/jorgejr568/cloudflare-cli/internal/constants"
+	"github.com/jorgejr568/cloudflare-cli/internal/dryrun"
+	"github.com/jorgejr568/cloudflare-cli/internal/zonecache"
 	"github.com/spf13/cobra"
 	"go.uber.org/dig"
 	"log"
 	"net/http"
+	"os"
+	"path/filepath"
 )
 
 func Run() {
 	container := dig.New()
 
-	err := container.Provide(func() (config.Composite, error) {
-		return config.NewComposite()
+	err := container.Provide(acquireGlobalFlags)
+	if err != nil {
+		log.Fatalf("failed to load global flags: %v", err)
+	}
+	err = container.Provide(func(flags *globalFlags) (config.Composite, error) {
+		return config.NewComposite(flags.profile)
 	})
 	if err != nil {
 		log.Fatalf("failed to load config composite: %v", err)
 	}
-	err = container.Provide(func() (*http.Client, error) {
-		return &http.Client{}, nil
+	err = container.Provide(func(flags *globalFlags, credentials cloudflare.Credentials) (*http.Client, traceLog, error) {
+		return flags.httpClient(credentials)
 	})
 	if err != nil {
 		log.Fatalf("failed to load http client: %v", err)
 	}
-	err = container.Provide(func(client *http.Client, config config.Composite) (cloudflare.CloudflareClient, error) {
-		return cloudflare.NewHttpCloudflareClient(
+	err = container.Provide(func(config config.Composite) (cloudflare.Credentials, error) {
+		// Invalid credentials are reported by the root command, so commands that fix them, like "config set", still run.
+		credentials, _ := acquireCredentials(config)
+		return credentials, nil
+	})
+	if err != nil {
+		log.Fatalf("failed to load credentials: %v", err)
+	}
+	err = container.Provide(func(flags *globalFlags) (*zonecache.Cache, error) {
+		// A config that cannot be read is reported by the commands using it, the cache falls back to the default profile.
+		localConfig, _ := config.LoadLocalConfig()
+		return zonecache.NewCache(
+			filepath.Join(config.AcquireLocalConfigPath(), "cache", "zones.json"),
+			localConfig.ActiveProfile(flags.profile),
+			flags.zoneCacheTTL,
+		), nil
+	})
+	if err != nil {
+		log.Fatalf("failed to load zone cache: %v", err)
+	}
+	err = container.Provide(func(client *http.Client, credentials cloudflare.Credentials, flags *globalFlags, zoneCache *zonecache.Cache) (cloudflare.CloudflareClient, error) {
+		cloudflareClient := cloudflare.NewHttpCloudflareClient(
 			client,
-			config.CloudflareAPIKey(),
+			credentials,
 			constants.CloudflareAPIBaseURL,
-		), nil
+			flags.clientOptions()...,
+		)
+		if flags.zoneCacheTTL > 0 {
+			cloudflareClient = zonecache.NewCachedClient(cloudflareClient, zoneCache)
+		}
+		if flags.dryRun {
+			cloudflareClient = dryrun.NewClient(cloudflareClient, os.Stderr)
+		}
+		return cloudflareClient, nil
 	})
 	if err != nil {
 		log.Fatalf("failed to load cloudflare client: %v", err)
@@ -47,14 +89,39 @@ func Run() {
 	if err != nil {
 		log.Fatalf("failed to load dns commands: %v", err)
 	}
+	err = container.Invoke(zones.CmdZones)
+	if err != nil {
+		log.Fatalf("failed to load zones commands: %v", err)
+	}
+	err = container.Invoke(auth.CmdAuth)
+	if err != nil {
+		log.Fatalf("failed to load auth commands: %v", err)
+	}
 	err = container.Invoke(cmdconfig.CmdConfig)
 	if err != nil {
 		log.Fatalf("failed to load config commands: %v", err)
 	}
+	err = container.Invoke(cache.CmdCache)
+	if err != nil {
+		log.Fatalf("failed to load cache commands: %v", err)
+	}
+
+	_ = container.Invoke(func(cmd *cobra.Command, trace traceLog) {
+		err := cmd.Execute()
+		// os.Exit skips deferred calls, so the trace file is closed before the process exits either way.
+		if trace != nil {
+			if closeErr := trace.Close(); closeErr != nil {
+				cmd.PrintErr(messages.WarningMessage("Failed to close the trace file: " + closeErr.Error()))
+			}
+		}
+		if err == nil {
+			return
+		}
 
-	_ = container.Invoke(func(cmd *cobra.Command) {
-		if err := cmd.Execute(); err != nil {
-			log.Fatal(err)
+		var exitErr *exitcode.Error
+		if !errors.As(err, &exitErr) || exitErr.Err != nil {
+			cmd.PrintErr(messages.ErrorMessage(err))
 		}
+		os.Exit(exitcode.Of(err))
 	})
 }
@@ -0,0 +1,207 @@
+package output
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jorgejr568/cloudflare-cli/internal/constants"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+	"io"
+	"reflect"
+	"strings"
+	"text/template"
+)
+
+type Format string
+
+const (
+	FormatTable    Format = "table"
+	FormatJSON     Format = "json"
+	FormatYAML     Format = "yaml"
+	FormatCSV      Format = "csv"
+	FormatTSV      Format = "tsv"
+	FormatTemplate Format = "template"
+)
+
+var (
+	ErrInvalidFormat   = errors.New("invalid output format")
+	ErrMissingTemplate = errors.New("--template is required when --output is template")
+	formats            = []Format{
+		FormatTable,
+		FormatJSON,
+		FormatYAML,
+		FormatCSV,
+		FormatTSV,
+		FormatTemplate,
+	}
+)
+
+// Table is the tabular view of a command result, used by the table, csv and tsv formats.
+type Table struct {
+	Header table.Row
+	Rows   []table.Row
+}
+
+func ParseFormat(s string) (Format, error) {
+	for _, f := range formats {
+		if f == Format(s) {
+			return f, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %s", ErrInvalidFormat, s)
+}
+
+// AcquireFormat returns the format selected by the persistent --output flag.
+func AcquireFormat(cmd *cobra.Command) (Format, error) {
+	flag := cmd.Flag(constants.FlagOutput)
+	if flag == nil {
+		return FormatTable, nil
+	}
+
+	format, err := ParseFormat(flag.Value.String())
+	if err != nil {
+		return "", err
+	}
+
+	if format == FormatTemplate && acquireTemplate(cmd) == "" {
+		return "", ErrMissingTemplate
+	}
+
+	return format, nil
+}
+
+func acquireTemplate(cmd *cobra.Command) string {
+	flag := cmd.Flag(constants.FlagTemplate)
+	if flag == nil {
+		return ""
+	}
+	return flag.Value.String()
+}
+
+// Print writes a command result in the format selected by --output.
+// The table, csv and tsv formats render t, every other format serializes data as a whole.
+func Print(cmd *cobra.Command, data interface{}, t Table) error {
+	format, err := AcquireFormat(cmd)
+	if err != nil {
+		return err
+	}
+
+	w := cmd.OutOrStdout()
+	switch format {
+	case FormatJSON:
+		return printJSON(w, data)
+	case FormatYAML:
+		return printYAML(w, data)
+	case FormatCSV:
+		return printSeparated(w, t, ',')
+	case FormatTSV:
+		return printSeparated(w, t, '\t')
+	case FormatTemplate:
+		return printTemplate(w, data, acquireTemplate(cmd))
+	default:
+		printTable(w, t)
+		return nil
+	}
+}
+
+func printTable(w io.Writer, t Table) {
+	tw := table.NewWriter()
+	tw.SetOutputMirror(w)
+	tw.AppendHeader(t.Header)
+	tw.AppendRows(t.Rows)
+	tw.Render()
+}
+
+func printJSON(w io.Writer, data interface{}) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(data)
+}
+
+// printYAML goes through JSON first so YAML keys match the JSON field names of the API types.
+func printYAML(w io.Writer, data interface{}) error {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	var generic interface{}
+	if err := json.Unmarshal(raw, &generic); err != nil {
+		return err
+	}
+
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(generic); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+func printSeparated(w io.Writer, t Table, separator rune) error {
+	writer := csv.NewWriter(w)
+	writer.Comma = separator
+
+	if err := writer.Write(formatRow(t.Header)); err != nil {
+		return err
+	}
+	for _, row := range t.Rows {
+		if err := writer.Write(formatRow(row)); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func formatRow(row table.Row) []string {
+	cells := make([]string, len(row))
+	for i, cell := range row {
+		switch value := cell.(type) {
+		case []string:
+			cells[i] = strings.Join(value, ",")
+		case nil:
+			cells[i] = ""
+		default:
+			cells[i] = fmt.Sprint(value)
+		}
+	}
+	return cells
+}
+
+// printTemplate executes the template once per element when data is a slice, and once otherwise.
+func printTemplate(w io.Writer, data interface{}, text string) error {
+	tmpl, err := template.New("output").Funcs(template.FuncMap{
+		"join": strings.Join,
+		"json": func(v interface{}) (string, error) {
+			raw, err := json.Marshal(v)
+			return string(raw), err
+		},
+	}).Parse(text)
+	if err != nil {
+		return err
+	}
+
+	value := reflect.ValueOf(data)
+	if value.Kind() != reflect.Slice {
+		if err := tmpl.Execute(w, data); err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w)
+		return err
+	}
+
+	for i := 0; i < value.Len(); i++ {
+		if err := tmpl.Execute(w, value.Index(i).Interface()); err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintln(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
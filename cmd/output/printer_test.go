@@ -0,0 +1,164 @@
+package output
+
+import (
+	"bytes"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jorgejr568/cloudflare-cli/internal/constants"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type item struct {
+	ID   string   `json:"id"`
+	Name string   `json:"name"`
+	Tags []string `json:"tags,omitempty"`
+}
+
+func newCommand(t *testing.T, args ...string) (*cobra.Command, *bytes.Buffer) {
+	cmd := &cobra.Command{Use: "test"}
+	cmd.Flags().StringP(constants.FlagOutput, "o", string(FormatTable), "")
+	cmd.Flags().String(constants.FlagTemplate, "", "")
+	assert.NoError(t, cmd.ParseFlags(args))
+
+	var stdout bytes.Buffer
+	cmd.SetOut(&stdout)
+	return cmd, &stdout
+}
+
+func itemsTable(items []item) Table {
+	t := Table{Header: table.Row{"ID", "Name", "Tags"}}
+	for _, i := range items {
+		t.Rows = append(t.Rows, table.Row{i.ID, i.Name, i.Tags})
+	}
+	return t
+}
+
+func TestPrint(t *testing.T) {
+	items := []item{
+		{ID: "1", Name: "www.example.com", Tags: []string{"env:prod", "team:web"}},
+		{ID: "2", Name: `say "hi", then leave`},
+	}
+	tests := []struct {
+		name string
+		args []string
+		data interface{}
+		want string
+	}{
+		{
+			name: "it should print indented json",
+			args: []string{"-o", "json"},
+			data: items[1:],
+			want: "[\n  {\n    \"id\": \"2\",\n    \"name\": \"say \\\"hi\\\", then leave\"\n  }\n]\n",
+		},
+		{
+			name: "it should print yaml with the json field names",
+			args: []string{"-o", "yaml"},
+			data: items[0],
+			want: "id: \"1\"\nname: www.example.com\ntags:\n  - env:prod\n  - team:web\n",
+		},
+		{
+			name: "it should quote csv fields with commas or quotes",
+			args: []string{"-o", "csv"},
+			data: items,
+			want: "ID,Name,Tags\n1,www.example.com,\"env:prod,team:web\"\n2,\"say \"\"hi\"\", then leave\",\n",
+		},
+		{
+			name: "it should separate tsv fields with tabs without quoting commas",
+			args: []string{"-o", "tsv"},
+			data: items,
+			want: "ID\tName\tTags\n1\twww.example.com\tenv:prod,team:web\n2\t\"say \"\"hi\"\", then leave\"\t\n",
+		},
+		{
+			name: "it should execute the template once per element",
+			args: []string{"-o", "template", "--template", "{{.ID}} {{.Name}} {{join .Tags \"|\"}}"},
+			data: items,
+			want: "1 www.example.com env:prod|team:web\n2 say \"hi\", then leave \n",
+		},
+		{
+			name: "it should execute the template once for a single result",
+			args: []string{"-o", "template", "--template", "{{json .}}"},
+			data: items[1],
+			want: "{\"id\":\"2\",\"name\":\"say \\\"hi\\\", then leave\"}\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, stdout := newCommand(t, tt.args...)
+			var view []item
+			switch data := tt.data.(type) {
+			case []item:
+				view = data
+			case item:
+				view = []item{data}
+			}
+
+			assert.NoError(t, Print(cmd, tt.data, itemsTable(view)))
+			assert.Equal(t, tt.want, stdout.String())
+		})
+	}
+}
+
+func TestPrint_Table(t *testing.T) {
+	cmd, stdout := newCommand(t)
+
+	assert.NoError(t, Print(cmd, nil, itemsTable([]item{{ID: "1", Name: "www.example.com"}})))
+	assert.Contains(t, stdout.String(), "ID")
+	assert.Contains(t, stdout.String(), "www.example.com")
+}
+
+func TestPrint_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "it should reject an unknown format",
+			args: []string{"-o", "xml"},
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, ErrInvalidFormat) && assert.ErrorContains(t, err, "xml")
+			},
+		},
+		{
+			name: "it should require a template with the template format",
+			args: []string{"-o", "template"},
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, ErrMissingTemplate)
+			},
+		},
+		{
+			name: "it should fail on a template that does not parse",
+			args: []string{"-o", "template", "--template", "{{.ID"},
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorContains(t, err, "unclosed action")
+			},
+		},
+		{
+			name: "it should fail on a template referencing a missing field",
+			args: []string{"-o", "template", "--template", "{{.Missing}}"},
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorContains(t, err, "Missing")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, stdout := newCommand(t, tt.args...)
+
+			tt.wantErr(t, Print(cmd, []item{{ID: "1"}}, itemsTable(nil)))
+			assert.Empty(t, stdout.String())
+		})
+	}
+}
+
+func TestAcquireFormat(t *testing.T) {
+	format, err := AcquireFormat(&cobra.Command{})
+	assert.NoError(t, err, "it should default to table without the flag")
+	assert.Equal(t, FormatTable, format)
+
+	cmd, _ := newCommand(t, "-o", "tsv")
+	format, err = AcquireFormat(cmd)
+	assert.NoError(t, err)
+	assert.Equal(t, FormatTSV, format)
+}
@@ -0,0 +1,75 @@
+package prompt
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"github.com/jorgejr568/cloudflare-cli/internal/constants"
+	"github.com/spf13/cobra"
+	"io"
+	"os"
+	"strings"
+)
+
+var (
+	// ErrAborted is returned when the user does not confirm a destructive action.
+	ErrAborted = errors.New("aborted")
+	// ErrNotInteractive is returned when a destructive action needs confirmation but stdin is not a terminal.
+	ErrNotInteractive = errors.New("confirmation required")
+)
+
+// Confirm asks a yes/no question on stderr and reads the answer from stdin, anything but yes aborts.
+// preview, when not nil, shows what is about to be affected right before the question.
+// It does not ask with --yes or --dry-run, and refuses without asking when stdin is not a terminal,
+// so a script never hangs on a prompt nor deletes something by piping "y".
+func Confirm(cmd *cobra.Command, question string, preview func() error) error {
+	in := cmd.InOrStdin()
+	return confirm(cmd, in, isTerminal(in), question, preview)
+}
+
+func confirm(cmd *cobra.Command, in io.Reader, interactive bool, question string, preview func() error) error {
+	if flagEnabled(cmd, constants.FlagYes) || flagEnabled(cmd, constants.FlagDryRun) {
+		return nil
+	}
+	if !interactive {
+		return fmt.Errorf("%w: stdin is not a terminal, run again with --%s to confirm", ErrNotInteractive, constants.FlagYes)
+	}
+
+	if preview != nil {
+		if err := preview(); err != nil {
+			return err
+		}
+	}
+
+	cmd.PrintErrf("%s [y/N]: ", question)
+	answer, err := bufio.NewReader(in).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return nil
+	default:
+		return ErrAborted
+	}
+}
+
+func flagEnabled(cmd *cobra.Command, name string) bool {
+	flag := cmd.Flag(name)
+	return flag != nil && flag.Value.String() == "true"
+}
+
+// isTerminal reports whether the reader is a terminal, pipes, files and any other reader are not.
+func isTerminal(in io.Reader) bool {
+	file, ok := in.(*os.File)
+	if !ok {
+		return false
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,156 @@
+package prompt
+
+import (
+	"bytes"
+	"errors"
+	"github.com/jorgejr568/cloudflare-cli/internal/constants"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func newCommand(t *testing.T, args ...string) (*cobra.Command, *bytes.Buffer) {
+	cmd := &cobra.Command{Use: "test"}
+	cmd.Flags().Bool(constants.FlagYes, false, "")
+	cmd.Flags().Bool(constants.FlagDryRun, false, "")
+	assert.NoError(t, cmd.ParseFlags(args))
+
+	var stderr bytes.Buffer
+	cmd.SetErr(&stderr)
+	return cmd, &stderr
+}
+
+func TestConfirm(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        []string
+		interactive bool
+		input       string
+		wantPreview bool
+		wantPrompt  bool
+		wantErr     error
+	}{
+		{
+			name:        "it should confirm on y",
+			interactive: true,
+			input:       "y\n",
+			wantPreview: true,
+			wantPrompt:  true,
+		},
+		{
+			name:        "it should confirm on yes in any case",
+			interactive: true,
+			input:       " YES \n",
+			wantPreview: true,
+			wantPrompt:  true,
+		},
+		{
+			name:        "it should abort on no",
+			interactive: true,
+			input:       "n\n",
+			wantPreview: true,
+			wantPrompt:  true,
+			wantErr:     ErrAborted,
+		},
+		{
+			name:        "it should abort on an empty answer",
+			interactive: true,
+			input:       "\n",
+			wantPreview: true,
+			wantPrompt:  true,
+			wantErr:     ErrAborted,
+		},
+		{
+			name:        "it should abort on any other answer",
+			interactive: true,
+			input:       "yep\n",
+			wantPreview: true,
+			wantPrompt:  true,
+			wantErr:     ErrAborted,
+		},
+		{
+			name:        "it should abort when stdin ends without an answer",
+			interactive: true,
+			wantPreview: true,
+			wantPrompt:  true,
+			wantErr:     ErrAborted,
+		},
+		{
+			name:        "it should refuse without a terminal",
+			interactive: false,
+			input:       "y\n",
+			wantErr:     ErrNotInteractive,
+		},
+		{
+			name:        "it should skip the question with --yes",
+			args:        []string{"--" + constants.FlagYes},
+			interactive: true,
+			input:       "n\n",
+		},
+		{
+			name:        "it should skip the question with --yes without a terminal",
+			args:        []string{"--" + constants.FlagYes},
+			interactive: false,
+		},
+		{
+			name:        "it should skip the question with --dry-run",
+			args:        []string{"--" + constants.FlagDryRun},
+			interactive: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, stderr := newCommand(t, tt.args...)
+			previewed := false
+			preview := func() error {
+				previewed = true
+				return nil
+			}
+
+			err := confirm(cmd, strings.NewReader(tt.input), tt.interactive, "Delete it?", preview)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantPreview, previewed)
+			assert.Equal(t, tt.wantPrompt, strings.Contains(stderr.String(), "Delete it? [y/N]: "))
+		})
+	}
+}
+
+func TestConfirm_PreviewError(t *testing.T) {
+	cmd, stderr := newCommand(t)
+	previewErr := errors.New("preview failed")
+
+	err := confirm(cmd, strings.NewReader("y\n"), true, "Delete it?", func() error {
+		return previewErr
+	})
+	assert.ErrorIs(t, err, previewErr)
+	assert.Empty(t, stderr.String(), "it should not ask after a failed preview")
+}
+
+func TestConfirm_NotTerminal(t *testing.T) {
+	cmd, _ := newCommand(t)
+	cmd.SetIn(strings.NewReader("y\n"))
+
+	assert.ErrorIs(t, Confirm(cmd, "Delete it?", nil), ErrNotInteractive)
+}
+
+func TestIsTerminal(t *testing.T) {
+	file, err := os.Create(filepath.Join(t.TempDir(), "stdin"))
+	assert.NoError(t, err)
+	defer file.Close()
+
+	reader, writer, err := os.Pipe()
+	assert.NoError(t, err)
+	defer reader.Close()
+	defer writer.Close()
+
+	assert.False(t, isTerminal(strings.NewReader("y\n")), "it should not treat a reader as a terminal")
+	assert.False(t, isTerminal(file), "it should not treat a regular file as a terminal")
+	assert.False(t, isTerminal(reader), "it should not treat a pipe as a terminal")
+}
@@ -1,10 +1,87 @@
 package cmd
 
-import "github.com/spf13/cobra"
+import (
+	"fmt"
+	"github.com/jorgejr568/cloudflare-cli/cmd/exitcode"
+	"github.com/jorgejr568/cloudflare-cli/cmd/messages"
+	"github.com/jorgejr568/cloudflare-cli/cmd/output"
+	"github.com/jorgejr568/cloudflare-cli/internal/config"
+	"github.com/jorgejr568/cloudflare-cli/internal/constants"
+	"github.com/spf13/cobra"
+)
 
-func cmdRoot() (*cobra.Command, error) {
-	return &cobra.Command{
+func cmdRoot(flags *globalFlags, composite config.Composite) (*cobra.Command, error) {
+	cmd := &cobra.Command{
 		Use:   "cloudflare-cli",
 		Short: "A CLI for interacting with the Cloudflare API",
-	}, nil
+		// Errors are printed once by Run, which also maps them to the process exit code.
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			// cobra validates these after the pre-run hooks, checking them here lets them be reported as usage errors.
+			if err := cmd.ValidateRequiredFlags(); err != nil {
+				return exitcode.Usagef("%s", err.Error())
+			}
+			if err := cmd.ValidateFlagGroups(); err != nil {
+				return exitcode.Usagef("%s", err.Error())
+			}
+
+			if !profileOptional(cmd) {
+				if err := requireProfile(cmd); err != nil {
+					return err
+				}
+				if _, err := acquireCredentials(composite); err != nil {
+					return err
+				}
+			}
+
+			if flags.dryRun {
+				cmd.Print(messages.WarningMessage("Dry run, changes are printed instead of sent to the API"))
+			}
+
+			_, err := output.AcquireFormat(cmd)
+			return err
+		},
+	}
+	cmd.SetFlagErrorFunc(func(cmd *cobra.Command, err error) error {
+		return exitcode.Usagef("%s", err.Error())
+	})
+
+	cmd.PersistentFlags().AddFlagSet(flags.flagSet)
+	cmd.PersistentFlags().StringP(constants.FlagOutput, "o", string(output.FormatTable), "Output format: table, json, yaml, csv, tsv or template")
+	cmd.PersistentFlags().String(constants.FlagTemplate, "", "Go template applied to each result when --output is template. Eg. '{{.ID}} {{.Name}}'")
+
+	return cmd, nil
+}
+
+// profileOptional reports whether the command belongs to a tree annotated with AnnotationProfileOptional,
+// which manages profiles and credentials and so must run while they are missing or invalid.
+func profileOptional(cmd *cobra.Command) bool {
+	for c := cmd; c != nil; c = c.Parent() {
+		if _, ok := c.Annotations[constants.AnnotationProfileOptional]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// requireProfile fails when the profile selected by --profile or CLOUDFLARE_PROFILE does not exist,
+// instead of silently running without credentials.
+func requireProfile(cmd *cobra.Command) error {
+	profile := cmd.Flag(constants.FlagProfile).Value.String()
+	if profile == "" {
+		profile = config.ProfileFromEnv()
+	}
+	if profile == "" {
+		return nil
+	}
+
+	localConfig, err := config.LoadLocalConfig()
+	if err != nil {
+		return err
+	}
+	if _, ok := localConfig.Profile(profile); !ok {
+		return fmt.Errorf("%w: %s", config.ErrProfileNotFound, profile)
+	}
+	return nil
 }
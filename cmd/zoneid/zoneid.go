@@ -0,0 +1,28 @@
+package zoneid
+
+import (
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+	"github.com/jorgejr568/cloudflare-cli/internal/constants"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// AddFlag registers --zone-id on a command that takes a domain.
+func AddFlag(flags *pflag.FlagSet) {
+	flags.String(constants.FlagZoneID, "", "ID of the zone of the domain, skips looking it up")
+}
+
+// Acquire returns the --zone-id flag, or looks up the zone of the domain when it was not passed.
+func Acquire(cmd *cobra.Command, client cloudflare.CloudflareClient, domain string) (string, error) {
+	if flag := cmd.Flag(constants.FlagZoneID); flag != nil && flag.Value.String() != "" {
+		return flag.Value.String(), nil
+	}
+
+	zone, err := client.GetZoneByDomain(cmd.Context(), cloudflare.GetZoneByDomainRequest{
+		Domain: domain,
+	})
+	if err != nil {
+		return "", err
+	}
+	return zone.ZoneID, nil
+}
@@ -0,0 +1,78 @@
+package zoneid
+
+import (
+	"context"
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type fakeClient struct {
+	cloudflare.CloudflareClient
+	lookups int
+}
+
+func (f *fakeClient) GetZoneByDomain(_ context.Context, request cloudflare.GetZoneByDomainRequest) (*cloudflare.GetZoneByDomainResponse, error) {
+	f.lookups++
+	if request.Domain != "example.com" {
+		return nil, cloudflare.ErrZoneNotFound
+	}
+	return &cloudflare.GetZoneByDomainResponse{ZoneID: "looked-up"}, nil
+}
+
+func TestAcquire(t *testing.T) {
+	tests := []struct {
+		name     string
+		register bool
+		args     []string
+		domain   string
+		expected string
+		lookups  int
+		err      error
+	}{
+		{
+			name:     "it should use --zone-id without looking the zone up",
+			register: true,
+			args:     []string{"--zone-id", "given"},
+			domain:   "example.com",
+			expected: "given",
+		},
+		{
+			name:     "it should look the zone up when --zone-id is not passed",
+			register: true,
+			domain:   "example.com",
+			expected: "looked-up",
+			lookups:  1,
+		},
+		{
+			name:     "it should look the zone up when the command has no --zone-id",
+			domain:   "example.com",
+			expected: "looked-up",
+			lookups:  1,
+		},
+		{
+			name:    "it should return the lookup error",
+			domain:  "missing.com",
+			lookups: 1,
+			err:     cloudflare.ErrZoneNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &cobra.Command{Use: "test"}
+			if tt.register {
+				AddFlag(cmd.Flags())
+			}
+			assert.NoError(t, cmd.ParseFlags(tt.args))
+			cmd.SetContext(context.Background())
+
+			client := &fakeClient{}
+			zoneID, err := Acquire(cmd, client, tt.domain)
+			assert.ErrorIs(t, err, tt.err)
+			assert.Equal(t, tt.expected, zoneID)
+			assert.Equal(t, tt.lookups, client.lookups)
+		})
+	}
+}
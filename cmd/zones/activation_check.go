@@ -0,0 +1,47 @@
+package zones
+
+import (
+	"fmt"
+	"github.com/jorgejr568/cloudflare-cli/cmd/messages"
+	"github.com/jorgejr568/cloudflare-cli/cmd/zoneid"
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+	"github.com/spf13/cobra"
+	"strings"
+)
+
+const zoneStatusActive = "active"
+
+func cmdZonesActivationCheck(rootCmd *cobra.Command, client cloudflare.CloudflareClient) error {
+	cmd := &cobra.Command{
+		Use:   "activation-check <domain>",
+		Short: "Ask Cloudflare to check the name servers of a pending zone again",
+		Long: "Triggers a new check of the name servers of a pending zone, so it is activated as soon as the registrar " +
+			"points at Cloudflare. Cloudflare limits how often a zone can be checked.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			zone, err := acquireZone(cmd, client, args[0])
+			if err != nil {
+				return err
+			}
+			if zone.Status == zoneStatusActive {
+				cmd.Print(messages.SuccessMessage(fmt.Sprintf("Zone %s is already active", zone.Name)))
+				return nil
+			}
+
+			err = client.ZoneActivationCheck(cmd.Context(), cloudflare.ZoneActivationCheckRequest{
+				ZoneID: zone.ID,
+			})
+			if err != nil {
+				return err
+			}
+
+			cmd.Print(messages.SuccessMessage(fmt.Sprintf("Activation check requested for %s, it is activated once its name servers are %s",
+				zone.Name, strings.Join(zone.NameServers, ", "))))
+			return nil
+		},
+	}
+
+	zoneid.AddFlag(cmd.Flags())
+	rootCmd.AddCommand(cmd)
+	return nil
+}
@@ -0,0 +1,55 @@
+package zones
+
+import (
+	"fmt"
+	"github.com/jorgejr568/cloudflare-cli/cmd/messages"
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+	"github.com/jorgejr568/cloudflare-cli/internal/constants"
+	"github.com/spf13/cobra"
+	"strings"
+)
+
+func cmdZonesCreate(rootCmd *cobra.Command, client cloudflare.CloudflareClient) error {
+	cmd := &cobra.Command{
+		Use:   "create <domain>",
+		Short: "Add a zone to an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			setup, err := cloudflare.ParseZoneSetup(cmd.Flag(constants.FlagType).Value.String())
+			if err != nil {
+				return err
+			}
+
+			response, err := client.CreateZone(cmd.Context(), cloudflare.CreateZoneRequest{
+				Name:      args[0],
+				AccountID: cmd.Flag(constants.FlagAccountID).Value.String(),
+				Type:      setup,
+			})
+			if err != nil {
+				return err
+			}
+
+			zone := response.Zone
+			if err := printZone(cmd, zone); err != nil {
+				return err
+			}
+
+			if setup == cloudflare.ZoneSetupPartial {
+				cmd.Print(messages.SuccessMessage(fmt.Sprintf("Zone %s created, verify it by adding a TXT record cloudflare-verify.%s with the value %s at your DNS provider",
+					zone.Name, zone.Name, zone.VerificationKey)))
+				return nil
+			}
+			cmd.Print(messages.SuccessMessage(fmt.Sprintf("Zone %s created, update the name servers at your registrar to: %s",
+				zone.Name, strings.Join(zone.NameServers, ", "))))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(constants.FlagAccountID, "", "ID of the account the zone is added to")
+	cmd.Flags().String(constants.FlagType, string(cloudflare.ZoneSetupFull), "Setup of the zone: full, with Cloudflare as the authoritative DNS, or partial, through CNAME records")
+
+	cmd.MarkFlagRequired(constants.FlagAccountID)
+
+	rootCmd.AddCommand(cmd)
+	return nil
+}
@@ -0,0 +1,46 @@
+package zones
+
+import (
+	"fmt"
+	"github.com/jorgejr568/cloudflare-cli/cmd/messages"
+	"github.com/jorgejr568/cloudflare-cli/cmd/prompt"
+	"github.com/jorgejr568/cloudflare-cli/cmd/zoneid"
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+	"github.com/spf13/cobra"
+)
+
+func cmdZonesDelete(rootCmd *cobra.Command, client cloudflare.CloudflareClient) error {
+	cmd := &cobra.Command{
+		Use:   "delete <domain>",
+		Short: "Delete a zone and all of its configuration",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			domain := args[0]
+			zone, err := acquireZone(cmd, client, domain)
+			if err != nil {
+				return err
+			}
+
+			err = prompt.Confirm(cmd, fmt.Sprintf("Delete zone %s with all of its DNS records and settings?", domain), func() error {
+				return printZones(cmd, []cloudflare.Zone{zone})
+			})
+			if err != nil {
+				return err
+			}
+
+			err = client.DeleteZone(cmd.Context(), cloudflare.DeleteZoneRequest{
+				ZoneID: zone.ID,
+			})
+			if err != nil {
+				return err
+			}
+
+			cmd.Print(messages.SuccessMessage(fmt.Sprintf("Zone %s deleted", domain)))
+			return nil
+		},
+	}
+
+	zoneid.AddFlag(cmd.Flags())
+	rootCmd.AddCommand(cmd)
+	return nil
+}
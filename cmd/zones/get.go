@@ -0,0 +1,27 @@
+package zones
+
+import (
+	"github.com/jorgejr568/cloudflare-cli/cmd/zoneid"
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+	"github.com/spf13/cobra"
+)
+
+func cmdZonesGet(rootCmd *cobra.Command, client cloudflare.CloudflareClient) error {
+	cmd := &cobra.Command{
+		Use:   "get <domain>",
+		Short: "Show the details of a zone",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			zone, err := acquireZone(cmd, client, args[0])
+			if err != nil {
+				return err
+			}
+
+			return printZone(cmd, zone)
+		},
+	}
+
+	zoneid.AddFlag(cmd.Flags())
+	rootCmd.AddCommand(cmd)
+	return nil
+}
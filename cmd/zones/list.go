@@ -0,0 +1,43 @@
+package zones
+
+import (
+	"github.com/jorgejr568/cloudflare-cli/cmd/exitcode"
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+	"github.com/jorgejr568/cloudflare-cli/internal/constants"
+	"github.com/spf13/cobra"
+	"slices"
+	"strings"
+)
+
+func cmdZonesList(rootCmd *cobra.Command, client cloudflare.CloudflareClient) error {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the zones the credentials can access",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			status := cmd.Flag(constants.FlagStatus).Value.String()
+			if status != "" && !slices.Contains(cloudflare.ZoneStatuses, status) {
+				return exitcode.Usagef("--%s must be one of: %s", constants.FlagStatus, strings.Join(cloudflare.ZoneStatuses, ", "))
+			}
+
+			response, err := client.ListZones(cmd.Context(), cloudflare.ListZonesRequest{
+				Name:        cmd.Flag(constants.FlagName).Value.String(),
+				Status:      status,
+				AccountID:   cmd.Flag(constants.FlagAccountID).Value.String(),
+				AccountName: cmd.Flag(constants.FlagAccountName).Value.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return printZones(cmd, response.Zones)
+		},
+	}
+
+	cmd.Flags().String(constants.FlagName, "", "Filter by zone name, eg. example.com or contains:example")
+	cmd.Flags().String(constants.FlagStatus, "", "Filter by status: "+strings.Join(cloudflare.ZoneStatuses, ", "))
+	cmd.Flags().String(constants.FlagAccountID, "", "Filter by the ID of the account owning the zone")
+	cmd.Flags().String(constants.FlagAccountName, "", "Filter by the name of the account owning the zone")
+
+	rootCmd.AddCommand(cmd)
+	return nil
+}
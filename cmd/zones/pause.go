@@ -0,0 +1,60 @@
+package zones
+
+import (
+	"fmt"
+	"github.com/jorgejr568/cloudflare-cli/cmd/messages"
+	"github.com/jorgejr568/cloudflare-cli/cmd/prompt"
+	"github.com/jorgejr568/cloudflare-cli/cmd/zoneid"
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+	"github.com/spf13/cobra"
+)
+
+// cmdZonesPause registers "pause", or "unpause" when paused is false.
+func cmdZonesPause(rootCmd *cobra.Command, client cloudflare.CloudflareClient, paused bool) error {
+	use, short, done := "pause", "Pause Cloudflare on a zone, serving its traffic straight from the origin", "paused"
+	if !paused {
+		use, short, done = "unpause", "Resume Cloudflare on a paused zone", "unpaused"
+	}
+
+	cmd := &cobra.Command{
+		Use:   use + " <domain>",
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			domain := args[0]
+			zoneID, err := zoneid.Acquire(cmd, client, domain)
+			if err != nil {
+				return err
+			}
+
+			// Pausing sends the traffic straight to the origin and exposes its address, unpausing is harmless.
+			if paused {
+				err = prompt.Confirm(cmd, fmt.Sprintf("Pause zone %s, exposing its origin to every visitor?", domain), func() error {
+					zone, err := client.GetZone(cmd.Context(), cloudflare.GetZoneRequest{ZoneID: zoneID})
+					if err != nil {
+						return err
+					}
+					return printZones(cmd, []cloudflare.Zone{zone.Zone})
+				})
+				if err != nil {
+					return err
+				}
+			}
+
+			_, err = client.PatchZone(cmd.Context(), cloudflare.PatchZoneRequest{
+				ZoneID: zoneID,
+				Paused: &paused,
+			})
+			if err != nil {
+				return err
+			}
+
+			cmd.Print(messages.SuccessMessage(fmt.Sprintf("Zone %s %s", domain, done)))
+			return nil
+		},
+	}
+
+	zoneid.AddFlag(cmd.Flags())
+	rootCmd.AddCommand(cmd)
+	return nil
+}
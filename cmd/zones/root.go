@@ -0,0 +1,56 @@
+package zones
+
+import (
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+	"github.com/spf13/cobra"
+)
+
+func CmdZones(rootCmd *cobra.Command, client cloudflare.CloudflareClient) error {
+	cmd := &cobra.Command{
+		Use:     "zones",
+		Aliases: []string{"zone"},
+		Short:   "Manage zones",
+	}
+	err := cmdZonesList(cmd, client)
+	if err != nil {
+		return err
+	}
+
+	err = cmdZonesGet(cmd, client)
+	if err != nil {
+		return err
+	}
+
+	err = cmdZonesCreate(cmd, client)
+	if err != nil {
+		return err
+	}
+
+	err = cmdZonesDelete(cmd, client)
+	if err != nil {
+		return err
+	}
+
+	err = cmdZonesPause(cmd, client, true)
+	if err != nil {
+		return err
+	}
+
+	err = cmdZonesPause(cmd, client, false)
+	if err != nil {
+		return err
+	}
+
+	err = cmdZonesActivationCheck(cmd, client)
+	if err != nil {
+		return err
+	}
+
+	err = cmdZonesSettings(cmd, client)
+	if err != nil {
+		return err
+	}
+
+	rootCmd.AddCommand(cmd)
+	return nil
+}
@@ -0,0 +1,129 @@
+package zones
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jorgejr568/cloudflare-cli/cmd/messages"
+	"github.com/jorgejr568/cloudflare-cli/cmd/output"
+	"github.com/jorgejr568/cloudflare-cli/cmd/zoneid"
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+	"github.com/spf13/cobra"
+	"strings"
+)
+
+func cmdZonesSettings(rootCmd *cobra.Command, client cloudflare.CloudflareClient) error {
+	cmd := &cobra.Command{
+		Use:   "settings",
+		Short: "Manage the settings of a zone, eg. SSL mode, Always Use HTTPS or HTTP/3",
+	}
+
+	list := &cobra.Command{
+		Use:   "list <domain>",
+		Short: "List every setting of a zone",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			zoneID, err := zoneid.Acquire(cmd, client, args[0])
+			if err != nil {
+				return err
+			}
+
+			response, err := client.ListZoneSettings(cmd.Context(), cloudflare.ListZoneSettingsRequest{
+				ZoneID: zoneID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return printSettings(cmd, response.Settings, response.Settings)
+		},
+	}
+
+	get := &cobra.Command{
+		Use:   "get <domain> <setting>",
+		Short: "Show a setting of a zone",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			zoneID, err := zoneid.Acquire(cmd, client, args[0])
+			if err != nil {
+				return err
+			}
+
+			response, err := client.GetZoneSetting(cmd.Context(), cloudflare.GetZoneSettingRequest{
+				ZoneID:    zoneID,
+				SettingID: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return printSettings(cmd, response.Setting, []cloudflare.ZoneSetting{response.Setting})
+		},
+	}
+
+	set := &cobra.Command{
+		Use:   "set <domain> <setting> <value>",
+		Short: "Change a setting of a zone",
+		Long: "Changes a setting of a zone after checking the value is one the setting accepts. Supported settings: " +
+			strings.Join(cloudflare.ZoneSettingIDs(), ", ") + ".",
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			domain, settingID := args[0], args[1]
+			value, err := cloudflare.ParseZoneSettingValue(settingID, args[2])
+			if err != nil {
+				return err
+			}
+
+			zoneID, err := zoneid.Acquire(cmd, client, domain)
+			if err != nil {
+				return err
+			}
+
+			response, err := client.UpdateZoneSetting(cmd.Context(), cloudflare.UpdateZoneSettingRequest{
+				ZoneID:    zoneID,
+				SettingID: settingID,
+				Value:     value,
+			})
+			if err != nil {
+				return err
+			}
+
+			if err := printSettings(cmd, response.Setting, []cloudflare.ZoneSetting{response.Setting}); err != nil {
+				return err
+			}
+			cmd.Print(messages.SuccessMessage(fmt.Sprintf("Setting %s of %s set to %s", settingID, domain, formatSettingValue(response.Setting.Value))))
+			return nil
+		},
+	}
+
+	zoneid.AddFlag(cmd.PersistentFlags())
+	cmd.AddCommand(list, get, set)
+	rootCmd.AddCommand(cmd)
+	return nil
+}
+
+func printSettings(cmd *cobra.Command, data interface{}, settings []cloudflare.ZoneSetting) error {
+	t := output.Table{
+		Header: table.Row{"Setting", "Value", "Editable", "Modified On"},
+	}
+	for _, setting := range settings {
+		t.Rows = append(t.Rows, table.Row{
+			setting.ID,
+			formatSettingValue(setting.Value),
+			setting.Editable,
+			setting.ModifiedOn,
+		})
+	}
+	return output.Print(cmd, data, t)
+}
+
+// formatSettingValue renders scalar values as they are and the object values of some settings as JSON.
+func formatSettingValue(value interface{}) string {
+	switch value.(type) {
+	case map[string]interface{}, []interface{}:
+		raw, _ := json.Marshal(value)
+		return string(raw)
+	default:
+		return fmt.Sprint(value)
+	}
+}
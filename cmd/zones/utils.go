@@ -0,0 +1,70 @@
+package zones
+
+import (
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jorgejr568/cloudflare-cli/cmd/output"
+	"github.com/jorgejr568/cloudflare-cli/cmd/zoneid"
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+	"github.com/spf13/cobra"
+	"strings"
+)
+
+// acquireZone looks up the zone of the domain and returns its details.
+func acquireZone(cmd *cobra.Command, client cloudflare.CloudflareClient, domain string) (cloudflare.Zone, error) {
+	zoneID, err := zoneid.Acquire(cmd, client, domain)
+	if err != nil {
+		return cloudflare.Zone{}, err
+	}
+
+	response, err := client.GetZone(cmd.Context(), cloudflare.GetZoneRequest{
+		ZoneID: zoneID,
+	})
+	if err != nil {
+		return cloudflare.Zone{}, err
+	}
+	return response.Zone, nil
+}
+
+func printZones(cmd *cobra.Command, zones []cloudflare.Zone) error {
+	t := output.Table{
+		Header: table.Row{"ID", "Name", "Status", "Plan", "Name Servers", "Paused", "Account"},
+	}
+	for _, zone := range zones {
+		t.Rows = append(t.Rows, table.Row{
+			zone.ID,
+			zone.Name,
+			zone.Status,
+			zone.Plan.Name,
+			strings.Join(zone.NameServers, "\n"),
+			zone.Paused,
+			zone.Account.Name,
+		})
+	}
+	return output.Print(cmd, zones, t)
+}
+
+func printZone(cmd *cobra.Command, zone cloudflare.Zone) error {
+	t := output.Table{
+		Header: table.Row{"Field", "Value"},
+		Rows: []table.Row{
+			{"ID", zone.ID},
+			{"Name", zone.Name},
+			{"Status", zone.Status},
+			{"Paused", zone.Paused},
+			{"Type", zone.Type},
+			{"Plan", zone.Plan.Name},
+			{"Account", zone.Account.Name},
+			{"Account ID", zone.Account.ID},
+			{"Name servers", strings.Join(zone.NameServers, "\n")},
+			{"Vanity name servers", strings.Join(zone.VanityNameServers, "\n")},
+			{"Original name servers", strings.Join(zone.OriginalNameServers, "\n")},
+			{"Original registrar", zone.OriginalRegistrar},
+			{"Original DNS host", zone.OriginalDNSHost},
+			{"Development mode", zone.DevelopmentMode},
+			{"Created on", zone.CreatedOn},
+			{"Activated on", zone.ActivatedOn},
+			{"Modified on", zone.ModifiedOn},
+		},
+	}
+	return output.Print(cmd, zone, t)
+}
@@ -0,0 +1,73 @@
+package bulk
+
+import (
+	"context"
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+	"sync"
+)
+
+// DefaultConcurrency is how many records are deleted at the same time unless told otherwise.
+// The client rate limit still applies on top of it.
+const DefaultConcurrency = 4
+
+// Result is the outcome of deleting a single record.
+type Result struct {
+	Record cloudflare.ZoneRecord `json:"record"`
+	Error  string                `json:"error,omitempty"`
+	Err    error                 `json:"-"`
+}
+
+// Delete deletes the records of the zone with up to concurrency requests in flight. Unlike reconcile.Apply
+// it does not stop at the first failure, every record is attempted and the results are in the order of records.
+// Records not attempted because the context was cancelled fail with the context error.
+func Delete(ctx context.Context, client cloudflare.CloudflareClient, zoneID string, records []cloudflare.ZoneRecord, concurrency int) []Result {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	results := make([]Result, len(records))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for worker := 0; worker < concurrency && worker < len(records); worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results[i] = deleteRecord(ctx, client, zoneID, records[i])
+			}
+		}()
+	}
+
+	for i := range records {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	return results
+}
+
+func deleteRecord(ctx context.Context, client cloudflare.CloudflareClient, zoneID string, record cloudflare.ZoneRecord) Result {
+	result := Result{Record: record}
+	if result.Err = ctx.Err(); result.Err == nil {
+		result.Err = client.DeleteZoneRecord(ctx, cloudflare.DeleteZoneRecordRequest{
+			ZoneID:   zoneID,
+			RecordID: record.ID,
+		})
+	}
+	if result.Err != nil {
+		result.Error = result.Err.Error()
+	}
+	return result
+}
+
+// Failed returns the results whose deletion failed.
+func Failed(results []Result) []Result {
+	var failed []Result
+	for _, result := range results {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
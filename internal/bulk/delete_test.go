@@ -0,0 +1,119 @@
+package bulk
+
+import (
+	"context"
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+	"github.com/stretchr/testify/assert"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type fakeClient struct {
+	cloudflare.CloudflareClient
+	mu       sync.Mutex
+	deleted  []string
+	failures map[string]error
+	inFlight int32
+	peak     int32
+	// overlap holds every delete until this many are in flight at once, or a second went by,
+	// so concurrent deletes are observed regardless of scheduling.
+	overlap int32
+}
+
+func (f *fakeClient) DeleteZoneRecord(_ context.Context, request cloudflare.DeleteZoneRecordRequest) error {
+	current := atomic.AddInt32(&f.inFlight, 1)
+	defer atomic.AddInt32(&f.inFlight, -1)
+	for {
+		peak := atomic.LoadInt32(&f.peak)
+		if current <= peak || atomic.CompareAndSwapInt32(&f.peak, peak, current) {
+			break
+		}
+	}
+	for deadline := time.Now().Add(time.Second); atomic.LoadInt32(&f.peak) < f.overlap && time.Now().Before(deadline); {
+		time.Sleep(time.Millisecond)
+	}
+
+	if err := f.failures[request.RecordID]; err != nil {
+		return err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.deleted = append(f.deleted, request.RecordID)
+	return nil
+}
+
+func TestDelete(t *testing.T) {
+	records := []cloudflare.ZoneRecord{{ID: "1"}, {ID: "2"}, {ID: "3"}, {ID: "4"}, {ID: "5"}}
+	tests := []struct {
+		name        string
+		concurrency int
+		failures    map[string]error
+		wantPeak    int
+		wantDeleted []string
+		wantFailed  []string
+	}{
+		{
+			name:        "it should delete every record",
+			concurrency: 2,
+			wantPeak:    2,
+			wantDeleted: []string{"1", "2", "3", "4", "5"},
+		},
+		{
+			name:        "it should keep going after a failure",
+			concurrency: 3,
+			wantPeak:    3,
+			failures:    map[string]error{"2": cloudflare.ErrRecordDeleteFailed, "4": cloudflare.ErrRateLimited},
+			wantDeleted: []string{"1", "3", "5"},
+			wantFailed:  []string{"2", "4"},
+		},
+		{
+			name:        "it should delete one at a time without a valid concurrency",
+			concurrency: 0,
+			wantPeak:    1,
+			wantDeleted: []string{"1", "2", "3", "4", "5"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &fakeClient{failures: tt.failures, overlap: int32(tt.wantPeak)}
+			results := Delete(context.Background(), client, "zone-id", records, tt.concurrency)
+
+			assert.Len(t, results, len(records))
+			for i, result := range results {
+				assert.Equal(t, records[i], result.Record)
+				if err := tt.failures[result.Record.ID]; err != nil {
+					assert.ErrorIs(t, result.Err, err)
+					assert.Equal(t, err.Error(), result.Error)
+				}
+			}
+
+			sort.Strings(client.deleted)
+			assert.Equal(t, tt.wantDeleted, client.deleted)
+
+			var failed []string
+			for _, result := range Failed(results) {
+				failed = append(failed, result.Record.ID)
+			}
+			assert.Equal(t, tt.wantFailed, failed)
+
+			assert.Equal(t, tt.wantPeak, int(client.peak), "it should keep exactly concurrency deletes in flight")
+		})
+	}
+}
+
+func TestDelete_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	client := &fakeClient{}
+	results := Delete(ctx, client, "zone-id", []cloudflare.ZoneRecord{{ID: "1"}, {ID: "2"}}, 2)
+
+	assert.Empty(t, client.deleted)
+	assert.Len(t, Failed(results), 2)
+	for _, result := range results {
+		assert.ErrorIs(t, result.Err, context.Canceled)
+	}
+}
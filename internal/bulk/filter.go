@@ -0,0 +1,74 @@
+package bulk
+
+import (
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+	"regexp"
+)
+
+// Filter selects DNS records, a record matches when it matches every field that is set.
+type Filter struct {
+	// Name is the full name of the record, eg. www.example.com.
+	Name    string
+	Type    cloudflare.ZoneType
+	Content string
+	// Tags must all be present on the record.
+	Tags    []string
+	Comment *regexp.Regexp
+}
+
+// Empty reports whether the filter has no field set, and so matches every record.
+func (f Filter) Empty() bool {
+	return f.Name == "" && f.Type == "" && f.Content == "" && len(f.Tags) == 0 && f.Comment == nil
+}
+
+// Request returns the records request for the zone narrowed by the fields the API filters on exactly.
+// Tags and the comment expression are checked with Match.
+func (f Filter) Request(zoneID string) cloudflare.GetZoneRecordsRequest {
+	return cloudflare.GetZoneRecordsRequest{
+		ZoneID:  zoneID,
+		Name:    f.Name,
+		Type:    f.Type,
+		Content: f.Content,
+	}
+}
+
+func (f Filter) Match(record cloudflare.ZoneRecord) bool {
+	if f.Name != "" && record.Name != f.Name {
+		return false
+	}
+	if f.Type != "" && record.Type != f.Type {
+		return false
+	}
+	if f.Content != "" && record.Content != f.Content {
+		return false
+	}
+	for _, tag := range f.Tags {
+		if !hasTag(record, tag) {
+			return false
+		}
+	}
+	if f.Comment != nil && !f.Comment.MatchString(record.Comment) {
+		return false
+	}
+	return true
+}
+
+// Select returns the records matching the filter, in their original order.
+func (f Filter) Select(records []cloudflare.ZoneRecord) []cloudflare.ZoneRecord {
+	var selected []cloudflare.ZoneRecord
+	for _, record := range records {
+		if f.Match(record) {
+			selected = append(selected, record)
+		}
+	}
+	return selected
+}
+
+func hasTag(record cloudflare.ZoneRecord, tag string) bool {
+	for _, recordTag := range record.Tags {
+		if recordTag == tag {
+			return true
+		}
+	}
+	return false
+}
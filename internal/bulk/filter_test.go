@@ -0,0 +1,99 @@
+package bulk
+
+import (
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"testing"
+)
+
+func TestFilter_Match(t *testing.T) {
+	record := cloudflare.ZoneRecord{
+		ID:      "1",
+		Name:    "www.example.com",
+		Type:    cloudflare.ZoneTypeA,
+		Content: "1.1.1.1",
+		Tags:    []string{"env:old", "team:web"},
+		Comment: "migrated from the old load balancer",
+	}
+	tests := []struct {
+		name   string
+		filter Filter
+		want   bool
+	}{
+		{
+			name:   "it should match every record with an empty filter",
+			filter: Filter{},
+			want:   true,
+		},
+		{
+			name:   "it should match when every field matches",
+			filter: Filter{Name: "www.example.com", Type: cloudflare.ZoneTypeA, Content: "1.1.1.1", Tags: []string{"env:old"}, Comment: regexp.MustCompile("^migrated")},
+			want:   true,
+		},
+		{
+			name:   "it should not match another name",
+			filter: Filter{Name: "api.example.com"},
+			want:   false,
+		},
+		{
+			name:   "it should not match another type",
+			filter: Filter{Type: cloudflare.ZoneTypeAAAA},
+			want:   false,
+		},
+		{
+			name:   "it should not match another content",
+			filter: Filter{Content: "2.2.2.2"},
+			want:   false,
+		},
+		{
+			name:   "it should match when the record has all the tags",
+			filter: Filter{Tags: []string{"team:web", "env:old"}},
+			want:   true,
+		},
+		{
+			name:   "it should not match when a tag is missing",
+			filter: Filter{Tags: []string{"env:old", "env:new"}},
+			want:   false,
+		},
+		{
+			name:   "it should not match a comment that does not match the expression",
+			filter: Filter{Comment: regexp.MustCompile("^old")},
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.filter.Match(record))
+		})
+	}
+}
+
+func TestFilter_Empty(t *testing.T) {
+	assert.True(t, Filter{}.Empty())
+	assert.False(t, Filter{Tags: []string{"env:old"}}.Empty())
+	assert.False(t, Filter{Comment: regexp.MustCompile(".")}.Empty())
+}
+
+func TestFilter_Select(t *testing.T) {
+	records := []cloudflare.ZoneRecord{
+		{ID: "1", Name: "www.example.com", Type: cloudflare.ZoneTypeA, Content: "1.1.1.1"},
+		{ID: "2", Name: "www.example.com", Type: cloudflare.ZoneTypeA, Content: "2.2.2.2", Tags: []string{"env:old"}},
+		{ID: "3", Name: "api.example.com", Type: cloudflare.ZoneTypeA, Content: "3.3.3.3", Tags: []string{"env:old"}},
+	}
+
+	selected := Filter{Tags: []string{"env:old"}}.Select(records)
+	assert.Equal(t, []cloudflare.ZoneRecord{records[1], records[2]}, selected)
+
+	assert.Empty(t, Filter{Content: "4.4.4.4"}.Select(records))
+}
+
+func TestFilter_Request(t *testing.T) {
+	filter := Filter{Name: "www.example.com", Type: cloudflare.ZoneTypeA, Content: "1.1.1.1"}
+	assert.Equal(t, cloudflare.GetZoneRecordsRequest{
+		ZoneID:  "zone-id",
+		Name:    "www.example.com",
+		Type:    cloudflare.ZoneTypeA,
+		Content: "1.1.1.1",
+	}, filter.Request("zone-id"))
+}
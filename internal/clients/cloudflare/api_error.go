@@ -0,0 +1,161 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// invalidCredentialCodes are the error codes Cloudflare answers with when the credentials themselves are wrong,
+// as opposed to valid credentials lacking a permission.
+var invalidCredentialCodes = map[int]bool{
+	1000: true, // Invalid API Token
+	6003: true, // Invalid request headers
+	6100: true, // Invalid format for X-Auth-Email header
+	6101: true, // Invalid format for X-Auth-Key header
+	6102: true, // Invalid format for X-Auth-User-Service-Key header
+	6103: true, // Invalid format for X-Auth-Key header
+	6111: true, // Invalid format for Authorization header
+	9103: true, // Unknown X-Auth-Key or X-Auth-Email
+	9106: true, // Missing X-Auth-Key, X-Auth-Email or Authorization headers
+	9107: true, // Missing X-Auth-Key or X-Auth-Email
+	9109: true, // Invalid access token
+}
+
+// APIErrorDetail is an entry of the errors or messages of a v4 API response.
+type APIErrorDetail struct {
+	Code       int              `json:"code"`
+	Message    string           `json:"message"`
+	ErrorChain []APIErrorDetail `json:"error_chain,omitempty"`
+}
+
+// UnmarshalJSON also accepts plain strings, which some endpoints return as messages.
+func (d *APIErrorDetail) UnmarshalJSON(data []byte) error {
+	var message string
+	if err := json.Unmarshal(data, &message); err == nil {
+		*d = APIErrorDetail{Message: message}
+		return nil
+	}
+
+	type detail APIErrorDetail
+	var decoded detail
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	*d = APIErrorDetail(decoded)
+	return nil
+}
+
+func (d APIErrorDetail) String() string {
+	text := d.Message
+	if d.Code != 0 {
+		text = fmt.Sprintf("%s (code %d)", d.Message, d.Code)
+	}
+	for _, chained := range d.ErrorChain {
+		text += ": " + chained.String()
+	}
+	return text
+}
+
+// APIError is a failed response of the Cloudflare v4 API. It matches, with errors.Is, the sentinel of the operation
+// that failed and, when it applies, ErrUnauthorized, ErrForbidden or ErrRateLimited.
+type APIError struct {
+	StatusCode int
+	Errors     []APIErrorDetail
+	Messages   []APIErrorDetail
+	// Body is the raw response when it is not a v4 envelope.
+	Body string
+
+	operation error
+	reason    error
+}
+
+func newAPIError(statusCode int, body []byte, operation error) *APIError {
+	apiErr := &APIError{
+		StatusCode: statusCode,
+		operation:  operation,
+	}
+
+	var envelope struct {
+		Errors   []APIErrorDetail `json:"errors"`
+		Messages []APIErrorDetail `json:"messages"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		apiErr.Body = strings.TrimSpace(string(body))
+	} else {
+		apiErr.Errors = envelope.Errors
+		apiErr.Messages = envelope.Messages
+	}
+
+	apiErr.reason = apiErr.classify()
+	return apiErr
+}
+
+func (e *APIError) classify() error {
+	switch e.StatusCode {
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusTooManyRequests:
+		return ErrRateLimited
+	case http.StatusForbidden:
+		// Without error codes there is no telling, and wrong credentials are the most likely cause.
+		if len(e.Errors) == 0 {
+			return ErrUnauthorized
+		}
+		for _, detail := range e.Errors {
+			if invalidCredentialCodes[detail.Code] {
+				return ErrUnauthorized
+			}
+		}
+		return ErrForbidden
+	default:
+		return nil
+	}
+}
+
+// Codes returns the codes of the errors in the response.
+func (e *APIError) Codes() []int {
+	codes := make([]int, 0, len(e.Errors))
+	for _, detail := range e.Errors {
+		codes = append(codes, detail.Code)
+	}
+	return codes
+}
+
+func (e *APIError) Error() string {
+	var details []string
+	for _, detail := range e.Errors {
+		details = append(details, detail.String())
+	}
+	if len(details) == 0 {
+		for _, detail := range e.Messages {
+			details = append(details, detail.String())
+		}
+	}
+
+	text := fmt.Sprintf("%s: %s", e.operation, strings.Join(details, "; "))
+	if len(details) == 0 {
+		text = fmt.Sprintf("%s: unexpected status code: %d", e.operation, e.StatusCode)
+		if e.Body != "" {
+			text += " - " + e.Body
+		}
+	}
+
+	switch e.reason {
+	case ErrUnauthorized:
+		text += ". The credentials are invalid or expired, check them with \"auth verify\""
+	case ErrForbidden:
+		text += ". The credentials are valid but lack a permission for this request, or the plan does not include it"
+	case ErrRateLimited:
+		text += ". Too many requests, try again later"
+	}
+	return text
+}
+
+func (e *APIError) Unwrap() []error {
+	if e.reason == nil {
+		return []error{e.operation}
+	}
+	return []error{e.operation, e.reason}
+}
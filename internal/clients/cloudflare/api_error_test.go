@@ -0,0 +1,121 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func TestNewAPIError(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+		wantIs     []error
+		wantNotIs  []error
+		wantCodes  []int
+		wantError  string
+	}{
+		{
+			name:       "it should parse the errors of the envelope",
+			statusCode: http.StatusBadRequest,
+			body:       `{"success":false,"errors":[{"code":9005,"message":"Content for A record is invalid. Must be a valid IPv4 address"}],"messages":[]}`,
+			wantIs:     []error{ErrRecordAddFailed},
+			wantNotIs:  []error{ErrUnauthorized, ErrForbidden},
+			wantCodes:  []int{9005},
+			wantError:  "record add failed: Content for A record is invalid. Must be a valid IPv4 address (code 9005)",
+		},
+		{
+			name:       "it should render the error chain",
+			statusCode: http.StatusBadRequest,
+			body:       `{"success":false,"errors":[{"code":1004,"message":"DNS Validation Error","error_chain":[{"code":9005,"message":"Content is invalid"}]}]}`,
+			wantIs:     []error{ErrRecordAddFailed},
+			wantCodes:  []int{1004},
+			wantError:  "record add failed: DNS Validation Error (code 1004): Content is invalid (code 9005)",
+		},
+		{
+			name:       "it should report a 403 with credential codes as unauthorized",
+			statusCode: http.StatusForbidden,
+			body:       `{"success":false,"errors":[{"code":9109,"message":"Invalid access token"}]}`,
+			wantIs:     []error{ErrRecordAddFailed, ErrUnauthorized},
+			wantNotIs:  []error{ErrForbidden},
+			wantCodes:  []int{9109},
+			wantError:  "record add failed: Invalid access token (code 9109). The credentials are invalid or expired, check them with \"auth verify\"",
+		},
+		{
+			name:       "it should report a 403 with other codes as forbidden",
+			statusCode: http.StatusForbidden,
+			body:       `{"success":false,"errors":[{"code":10000,"message":"Authentication error"}]}`,
+			wantIs:     []error{ErrRecordAddFailed, ErrForbidden},
+			wantNotIs:  []error{ErrUnauthorized},
+			wantCodes:  []int{10000},
+			wantError:  "record add failed: Authentication error (code 10000). The credentials are valid but lack a permission for this request, or the plan does not include it",
+		},
+		{
+			name:       "it should report a 403 without an envelope as unauthorized",
+			statusCode: http.StatusForbidden,
+			body:       `forbidden`,
+			wantIs:     []error{ErrRecordAddFailed, ErrUnauthorized},
+			wantCodes:  []int{},
+			wantError:  "record add failed: unexpected status code: 403 - forbidden. The credentials are invalid or expired, check them with \"auth verify\"",
+		},
+		{
+			name:       "it should report a 429 as rate limited",
+			statusCode: http.StatusTooManyRequests,
+			body:       `{"success":false,"errors":[{"code":971,"message":"Please wait and consider throttling your request speed"}]}`,
+			wantIs:     []error{ErrRecordAddFailed, ErrRateLimited},
+			wantCodes:  []int{971},
+			wantError:  "record add failed: Please wait and consider throttling your request speed (code 971). Too many requests, try again later",
+		},
+		{
+			name:       "it should fall back to string messages",
+			statusCode: http.StatusInternalServerError,
+			body:       `{"success":false,"errors":[],"messages":["maintenance in progress"]}`,
+			wantIs:     []error{ErrRecordAddFailed},
+			wantCodes:  []int{},
+			wantError:  "record add failed: maintenance in progress",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := newAPIError(tt.statusCode, []byte(tt.body), ErrRecordAddFailed)
+			for _, target := range tt.wantIs {
+				assert.ErrorIs(t, err, target)
+			}
+			for _, target := range tt.wantNotIs {
+				assert.NotErrorIs(t, err, target)
+			}
+			assert.Equal(t, tt.wantCodes, err.Codes())
+			assert.Equal(t, tt.wantError, err.Error())
+		})
+	}
+}
+
+func TestHttpCloudflareClient_APIError(t *testing.T) {
+	server := newMockServer(mockServerConfig{
+		path:       "/client/v4/zones/zone-id/dns_records",
+		method:     http.MethodPost,
+		statusCode: http.StatusBadRequest,
+		response: map[string]interface{}{
+			"success":  false,
+			"errors":   []map[string]interface{}{{"code": 81057, "message": "Record already exists."}},
+			"messages": []interface{}{},
+		},
+	})
+	defer server.Close()
+
+	client := NewHttpCloudflareClient(http.DefaultClient, APITokenCredentials("token"), server.URL)
+	_, err := client.AddZoneRecord(context.Background(), AddZoneRecordRequest{
+		ZoneID: "zone-id",
+		Record: ZoneRecordRequest{Type: ZoneTypeA, Name: "example.com", Content: "1.1.1.1"},
+	})
+
+	var apiErr *APIError
+	if assert.True(t, errors.As(err, &apiErr)) {
+		assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+		assert.Equal(t, []int{81057}, apiErr.Codes())
+	}
+	assert.ErrorIs(t, err, ErrRecordAddFailed)
+}
@@ -0,0 +1,88 @@
+package cloudflare
+
+import (
+	"fmt"
+	"net/http"
+)
+
+type AuthMethod string
+
+const (
+	AuthMethodToken       AuthMethod = "token"
+	AuthMethodGlobalKey   AuthMethod = "global_key"
+	AuthMethodOriginCAKey AuthMethod = "origin_ca_key"
+)
+
+func ParseAuthMethod(s string) (AuthMethod, error) {
+	switch method := AuthMethod(s); method {
+	case AuthMethodToken, AuthMethodGlobalKey, AuthMethodOriginCAKey:
+		return method, nil
+	default:
+		return "", fmt.Errorf("%w: %q, expected %s, %s or %s", ErrInvalidAuthMethod, s, AuthMethodToken, AuthMethodGlobalKey, AuthMethodOriginCAKey)
+	}
+}
+
+// Credentials authenticate requests with an API token, a Global API Key and its account email, or an Origin CA key.
+type Credentials struct {
+	Method       AuthMethod
+	APIToken     string
+	Email        string
+	GlobalAPIKey string
+	OriginCAKey  string
+}
+
+func APITokenCredentials(token string) Credentials {
+	return Credentials{Method: AuthMethodToken, APIToken: token}
+}
+
+func GlobalAPIKeyCredentials(email, key string) Credentials {
+	return Credentials{Method: AuthMethodGlobalKey, Email: email, GlobalAPIKey: key}
+}
+
+func OriginCAKeyCredentials(key string) Credentials {
+	return Credentials{Method: AuthMethodOriginCAKey, OriginCAKey: key}
+}
+
+// Validate reports the settings missing for the auth method.
+func (c Credentials) Validate() error {
+	switch c.Method {
+	case AuthMethodToken, "":
+		return nil
+	case AuthMethodGlobalKey:
+		if c.Email == "" || c.GlobalAPIKey == "" {
+			return fmt.Errorf("%w: %s requires both an email and a Global API Key", ErrInvalidAuthMethod, c.Method)
+		}
+		return nil
+	case AuthMethodOriginCAKey:
+		if c.OriginCAKey == "" {
+			return fmt.Errorf("%w: %s requires an Origin CA key", ErrInvalidAuthMethod, c.Method)
+		}
+		return nil
+	default:
+		_, err := ParseAuthMethod(string(c.Method))
+		return err
+	}
+}
+
+// Secrets returns the keys and tokens of the credentials, so they can be kept out of logs.
+func (c Credentials) Secrets() []string {
+	var secrets []string
+	for _, secret := range []string{c.APIToken, c.GlobalAPIKey, c.OriginCAKey} {
+		if secret != "" {
+			secrets = append(secrets, secret)
+		}
+	}
+	return secrets
+}
+
+func (c Credentials) apply(header http.Header) {
+	switch c.Method {
+	case AuthMethodGlobalKey:
+		header.Set("X-Auth-Email", c.Email)
+		header.Set("X-Auth-Key", c.GlobalAPIKey)
+	case AuthMethodOriginCAKey:
+		header.Set("X-Auth-User-Service-Key", c.OriginCAKey)
+	default:
+		header.Set("Authorization", fmt.Sprintf("Bearer %s", c.APIToken))
+	}
+}
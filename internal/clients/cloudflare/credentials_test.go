@@ -0,0 +1,80 @@
+package cloudflare
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func TestCredentials_apply(t *testing.T) {
+	tests := []struct {
+		name        string
+		credentials Credentials
+		want        map[string]string
+	}{
+		{
+			name:        "it should send an API token as a bearer token",
+			credentials: APITokenCredentials("token"),
+			want: map[string]string{
+				"Authorization":           "Bearer token",
+				"X-Auth-Email":            "",
+				"X-Auth-Key":              "",
+				"X-Auth-User-Service-Key": "",
+			},
+		},
+		{
+			name:        "it should send a Global API Key with its email",
+			credentials: GlobalAPIKeyCredentials("user@example.com", "global-key"),
+			want: map[string]string{
+				"Authorization":           "",
+				"X-Auth-Email":            "user@example.com",
+				"X-Auth-Key":              "global-key",
+				"X-Auth-User-Service-Key": "",
+			},
+		},
+		{
+			name:        "it should send an Origin CA key",
+			credentials: OriginCAKeyCredentials("origin-ca-key"),
+			want: map[string]string{
+				"Authorization":           "",
+				"X-Auth-Email":            "",
+				"X-Auth-Key":              "",
+				"X-Auth-User-Service-Key": "origin-ca-key",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := httpCloudflareClient{credentials: tt.credentials}
+			req, err := h.acquireRequest(context.Background(), http.MethodGet, "http://localhost", nil)
+			assert.NoError(t, err)
+			for header, value := range tt.want {
+				assert.Equal(t, value, req.Header.Get(header), header)
+			}
+		})
+	}
+}
+
+func TestCredentials_Validate(t *testing.T) {
+	assert.NoError(t, APITokenCredentials("token").Validate())
+	assert.NoError(t, GlobalAPIKeyCredentials("user@example.com", "global-key").Validate())
+	assert.ErrorIs(t, GlobalAPIKeyCredentials("", "global-key").Validate(), ErrInvalidAuthMethod)
+	assert.ErrorIs(t, OriginCAKeyCredentials("").Validate(), ErrInvalidAuthMethod)
+	assert.ErrorIs(t, Credentials{Method: "password"}.Validate(), ErrInvalidAuthMethod)
+}
+
+func TestParseAuthMethod(t *testing.T) {
+	got, err := ParseAuthMethod("global_key")
+	assert.NoError(t, err)
+	assert.Equal(t, AuthMethodGlobalKey, got)
+
+	_, err = ParseAuthMethod("password")
+	assert.ErrorIs(t, err, ErrInvalidAuthMethod)
+}
+
+func TestCredentials_Secrets(t *testing.T) {
+	assert.Equal(t, []string{"token"}, APITokenCredentials("token").Secrets())
+	assert.Equal(t, []string{"global-key"}, GlobalAPIKeyCredentials("user@example.com", "global-key").Secrets())
+	assert.Empty(t, Credentials{}.Secrets())
+}
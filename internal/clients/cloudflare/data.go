@@ -0,0 +1,264 @@
+package cloudflare
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var InvalidZoneRecordData = errors.New("invalid record data")
+
+// ZoneRecordData is the structured "data" object Cloudflare requires instead of "content" for some record types.
+type ZoneRecordData map[string]interface{}
+
+type dataField struct {
+	name     string
+	numeric  bool
+	optional bool
+}
+
+// zoneRecordDataFields lists the data fields of each structured record type in presentation (zone file) order.
+var zoneRecordDataFields = map[ZoneType][]dataField{
+	ZoneTypeSRV:    {{name: "priority", numeric: true}, {name: "weight", numeric: true}, {name: "port", numeric: true}, {name: "target"}},
+	ZoneTypeCAA:    {{name: "flags", numeric: true}, {name: "tag"}, {name: "value"}},
+	ZoneTypeHTTPS:  {{name: "priority", numeric: true}, {name: "target"}, {name: "value", optional: true}},
+	ZoneTypeSVCB:   {{name: "priority", numeric: true}, {name: "target"}, {name: "value", optional: true}},
+	ZoneTypeNAPTR:  {{name: "order", numeric: true}, {name: "preference", numeric: true}, {name: "flags"}, {name: "service"}, {name: "regex"}, {name: "replacement"}},
+	ZoneTypeSSHFP:  {{name: "algorithm", numeric: true}, {name: "type", numeric: true}, {name: "fingerprint"}},
+	ZoneTypeTLSA:   {{name: "usage", numeric: true}, {name: "selector", numeric: true}, {name: "matching_type", numeric: true}, {name: "certificate"}},
+	ZoneTypeSMIMEA: {{name: "usage", numeric: true}, {name: "selector", numeric: true}, {name: "matching_type", numeric: true}, {name: "certificate"}},
+	ZoneTypeDS:     {{name: "key_tag", numeric: true}, {name: "algorithm", numeric: true}, {name: "digest_type", numeric: true}, {name: "digest"}},
+	ZoneTypeDNSKEY: {{name: "flags", numeric: true}, {name: "protocol", numeric: true}, {name: "algorithm", numeric: true}, {name: "public_key"}},
+	ZoneTypeCERT:   {{name: "type", numeric: true}, {name: "key_tag", numeric: true}, {name: "algorithm", numeric: true}, {name: "certificate"}},
+	ZoneTypeURI:    {{name: "weight", numeric: true}, {name: "target"}},
+	ZoneTypeLOC: {
+		{name: "lat_degrees", numeric: true}, {name: "lat_minutes", numeric: true}, {name: "lat_seconds", numeric: true}, {name: "lat_direction"},
+		{name: "long_degrees", numeric: true}, {name: "long_minutes", numeric: true}, {name: "long_seconds", numeric: true}, {name: "long_direction"},
+		{name: "altitude", numeric: true}, {name: "size", numeric: true, optional: true},
+		{name: "precision_horz", numeric: true, optional: true}, {name: "precision_vert", numeric: true, optional: true},
+	},
+}
+
+// binaryDataTypes hold base64 or hex blobs in their last field, which zone files may split with whitespace.
+var binaryDataTypes = map[ZoneType]bool{
+	ZoneTypeTLSA:   true,
+	ZoneTypeSMIMEA: true,
+	ZoneTypeDS:     true,
+	ZoneTypeDNSKEY: true,
+	ZoneTypeCERT:   true,
+	ZoneTypeSSHFP:  true,
+}
+
+// RequiresData reports whether Cloudflare expects a structured data object rather than content for the type.
+func (z ZoneType) RequiresData() bool {
+	_, ok := zoneRecordDataFields[z]
+	return ok
+}
+
+// DataFields returns the names of the data fields accepted for the type.
+func (z ZoneType) DataFields() []string {
+	var names []string
+	for _, field := range zoneRecordDataFields[z] {
+		names = append(names, field.name)
+	}
+	return names
+}
+
+// ParseZoneRecordData builds the data object of a record from key=value pairs, converting numeric fields.
+func ParseZoneRecordData(zoneType ZoneType, pairs []string) (ZoneRecordData, error) {
+	fields, ok := zoneRecordDataFields[zoneType]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s records do not take data", InvalidZoneRecordData, zoneType)
+	}
+
+	data := ZoneRecordData{}
+	for _, pair := range pairs {
+		key, value, found := strings.Cut(pair, "=")
+		if !found {
+			return nil, fmt.Errorf("%w: expected key=value, got %q", InvalidZoneRecordData, pair)
+		}
+
+		field, ok := lookupDataField(fields, key)
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown %s field %q, expected one of %s", InvalidZoneRecordData, zoneType, key, strings.Join(zoneType.DataFields(), ", "))
+		}
+
+		converted, err := convertDataValue(field, value)
+		if err != nil {
+			return nil, err
+		}
+		data[key] = converted
+	}
+
+	var missing []string
+	for _, field := range fields {
+		if _, ok := data[field.name]; !ok && !field.optional {
+			missing = append(missing, field.name)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return nil, fmt.Errorf("%w: missing %s fields: %s", InvalidZoneRecordData, zoneType, strings.Join(missing, ", "))
+	}
+
+	return data, nil
+}
+
+// ZoneRecordDataFromFields builds the data object of a record from its presentation format fields, as found in zone files.
+// Character-strings must already be unquoted.
+func ZoneRecordDataFromFields(zoneType ZoneType, values []string) (ZoneRecordData, error) {
+	if zoneType == ZoneTypeLOC {
+		return locDataFromFields(values)
+	}
+
+	fields, ok := zoneRecordDataFields[zoneType]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s records do not take data", InvalidZoneRecordData, zoneType)
+	}
+
+	required := 0
+	for _, field := range fields {
+		if !field.optional {
+			required++
+		}
+	}
+	if len(values) < required {
+		return nil, fmt.Errorf("%w: %s expects at least %d fields, got %d", InvalidZoneRecordData, zoneType, required, len(values))
+	}
+
+	// The last field takes every remaining value, eg. split base64 keys or multiple SVCB parameters.
+	if len(values) > len(fields) {
+		separator := " "
+		if binaryDataTypes[zoneType] {
+			separator = ""
+		}
+		last := len(fields) - 1
+		values = append(values[:last:last], strings.Join(values[last:], separator))
+	}
+
+	data := ZoneRecordData{}
+	for i, value := range values {
+		converted, err := convertDataValue(fields[i], value)
+		if err != nil {
+			return nil, err
+		}
+		data[fields[i].name] = converted
+	}
+
+	return data, nil
+}
+
+// locDataFromFields parses the RFC 1876 presentation format, where minutes, seconds, size and precisions are optional.
+func locDataFromFields(values []string) (ZoneRecordData, error) {
+	data := ZoneRecordData{}
+	rest, err := parseLocCoordinate(values, "lat", "N", "S", data)
+	if err != nil {
+		return nil, err
+	}
+	rest, err = parseLocCoordinate(rest, "long", "E", "W", data)
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{"altitude", "size", "precision_horz", "precision_vert"}
+	if len(rest) == 0 {
+		return nil, fmt.Errorf("%w: LOC is missing the altitude", InvalidZoneRecordData)
+	}
+	if len(rest) > len(names) {
+		return nil, fmt.Errorf("%w: LOC has too many fields", InvalidZoneRecordData)
+	}
+	for i, value := range rest {
+		number, err := strconv.ParseFloat(strings.TrimSuffix(value, "m"), 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid LOC %s %q", InvalidZoneRecordData, names[i], value)
+		}
+		data[names[i]] = normalizeNumber(number)
+	}
+
+	return data, nil
+}
+
+func parseLocCoordinate(values []string, prefix, positive, negative string, data ZoneRecordData) ([]string, error) {
+	parts := []string{"degrees", "minutes", "seconds"}
+	for i := range parts {
+		data[prefix+"_"+parts[i]] = 0
+	}
+
+	for i := 0; i < len(values); i++ {
+		if values[i] == positive || values[i] == negative {
+			if i == 0 {
+				return nil, fmt.Errorf("%w: LOC %s is missing degrees", InvalidZoneRecordData, prefix)
+			}
+			data[prefix+"_direction"] = values[i]
+			return values[i+1:], nil
+		}
+		if i >= len(parts) {
+			break
+		}
+
+		number, err := strconv.ParseFloat(values[i], 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid LOC %s %s %q", InvalidZoneRecordData, prefix, parts[i], values[i])
+		}
+		data[prefix+"_"+parts[i]] = normalizeNumber(number)
+	}
+
+	return nil, fmt.Errorf("%w: LOC %s is missing the %s/%s direction", InvalidZoneRecordData, prefix, positive, negative)
+}
+
+func lookupDataField(fields []dataField, name string) (dataField, bool) {
+	for _, field := range fields {
+		if field.name == name {
+			return field, true
+		}
+	}
+	return dataField{}, false
+}
+
+func convertDataValue(field dataField, value string) (interface{}, error) {
+	if !field.numeric {
+		return value, nil
+	}
+
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: field %s must be a number, got %q", InvalidZoneRecordData, field.name, value)
+	}
+	return normalizeNumber(number), nil
+}
+
+// normalizeNumber keeps whole numbers as ints so they are encoded without a decimal point.
+func normalizeNumber(number float64) interface{} {
+	if number == math.Trunc(number) && math.Abs(number) < math.MaxInt32 {
+		return int(number)
+	}
+	return number
+}
+
+// String renders the data as sorted key=value pairs, the same form accepted by ParseZoneRecordData.
+func (d ZoneRecordData) String() string {
+	keys := make([]string, 0, len(d))
+	for key := range d {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, len(keys))
+	for i, key := range keys {
+		pairs[i] = fmt.Sprintf("%s=%v", key, d[key])
+	}
+	return strings.Join(pairs, " ")
+}
+
+// RecordIdentity returns a key identifying a record by name, type and value.
+// Structured types are compared by their data, since requests for them carry no content.
+func RecordIdentity(name string, zoneType ZoneType, content string, data ZoneRecordData) string {
+	value := content
+	if zoneType.RequiresData() && len(data) > 0 {
+		value = data.String()
+	}
+	return strings.ToLower(strings.TrimSuffix(name, ".")) + "|" + string(zoneType) + "|" + value
+}
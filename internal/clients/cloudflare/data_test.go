@@ -0,0 +1,154 @@
+package cloudflare
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseZoneType(t *testing.T) {
+	for _, zoneType := range []string{"CAA", "PTR", "HTTPS", "SVCB", "LOC", "NAPTR", "SSHFP", "TLSA", "DS", "DNSKEY", "CERT", "URI", "SMIMEA"} {
+		got, err := ParseZoneType(zoneType)
+		assert.NoError(t, err)
+		assert.Equal(t, ZoneType(zoneType), got)
+	}
+
+	_, err := ParseZoneType("BOGUS")
+	assert.ErrorIs(t, err, InvalidZoneType)
+}
+
+func TestParseZoneSetup(t *testing.T) {
+	setup, err := ParseZoneSetup("partial")
+	assert.NoError(t, err)
+	assert.Equal(t, ZoneSetupPartial, setup)
+
+	_, err = ParseZoneSetup("secondary")
+	assert.ErrorIs(t, err, ErrInvalidZoneSetup)
+}
+
+func TestParseZoneRecordData(t *testing.T) {
+	tests := []struct {
+		name     string
+		zoneType ZoneType
+		pairs    []string
+		want     ZoneRecordData
+		wantErr  assert.ErrorAssertionFunc
+	}{
+		{
+			name:     "it should convert numeric fields",
+			zoneType: ZoneTypeCAA,
+			pairs:    []string{"flags=0", "tag=issue", "value=letsencrypt.org"},
+			want:     ZoneRecordData{"flags": 0, "tag": "issue", "value": "letsencrypt.org"},
+			wantErr:  assert.NoError,
+		},
+		{
+			name:     "it should keep values containing equal signs",
+			zoneType: ZoneTypeHTTPS,
+			pairs:    []string{"priority=1", "target=.", "value=alpn=h2,h3"},
+			want:     ZoneRecordData{"priority": 1, "target": ".", "value": "alpn=h2,h3"},
+			wantErr:  assert.NoError,
+		},
+		{
+			name:     "it should reject unknown fields",
+			zoneType: ZoneTypeSRV,
+			pairs:    []string{"host=example.com"},
+			want:     nil,
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, InvalidZoneRecordData)
+			},
+		},
+		{
+			name:     "it should reject missing required fields",
+			zoneType: ZoneTypeSRV,
+			pairs:    []string{"priority=10", "weight=5"},
+			want:     nil,
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorContains(t, err, "port, target")
+			},
+		},
+		{
+			name:     "it should reject non numeric values for numeric fields",
+			zoneType: ZoneTypeSRV,
+			pairs:    []string{"priority=high", "weight=5", "port=80", "target=example.com"},
+			want:     nil,
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, InvalidZoneRecordData)
+			},
+		},
+		{
+			name:     "it should reject types without data",
+			zoneType: ZoneTypeA,
+			pairs:    []string{"address=1.1.1.1"},
+			want:     nil,
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, InvalidZoneRecordData)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseZoneRecordData(tt.zoneType, tt.pairs)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestZoneRecordDataFromFields(t *testing.T) {
+	tests := []struct {
+		name     string
+		zoneType ZoneType
+		values   []string
+		want     ZoneRecordData
+		wantErr  assert.ErrorAssertionFunc
+	}{
+		{
+			name:     "it should map SRV fields in order",
+			zoneType: ZoneTypeSRV,
+			values:   []string{"10", "5", "5060", "sip.example.com"},
+			want:     ZoneRecordData{"priority": 10, "weight": 5, "port": 5060, "target": "sip.example.com"},
+			wantErr:  assert.NoError,
+		},
+		{
+			name:     "it should join split binary fields without spaces",
+			zoneType: ZoneTypeDNSKEY,
+			values:   []string{"257", "3", "13", "abc", "def"},
+			want:     ZoneRecordData{"flags": 257, "protocol": 3, "algorithm": 13, "public_key": "abcdef"},
+			wantErr:  assert.NoError,
+		},
+		{
+			name:     "it should parse LOC records with optional fields",
+			zoneType: ZoneTypeLOC,
+			values:   []string{"52", "22", "23.5", "N", "4", "E", "-2.00m", "10m"},
+			want: ZoneRecordData{
+				"lat_degrees": 52, "lat_minutes": 22, "lat_seconds": 23.5, "lat_direction": "N",
+				"long_degrees": 4, "long_minutes": 0, "long_seconds": 0, "long_direction": "E",
+				"altitude": -2, "size": 10,
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:     "it should reject too few fields",
+			zoneType: ZoneTypeCAA,
+			values:   []string{"0", "issue"},
+			want:     nil,
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, InvalidZoneRecordData)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ZoneRecordDataFromFields(tt.zoneType, tt.values)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRecordIdentity(t *testing.T) {
+	assert.Equal(t, "www.example.com|A|1.1.1.1", RecordIdentity("WWW.example.com.", ZoneTypeA, "1.1.1.1", nil))
+	assert.Equal(t,
+		RecordIdentity("example.com", ZoneTypeCAA, "", ZoneRecordData{"flags": 0, "tag": "issue", "value": "ca.example"}),
+		RecordIdentity("example.com", ZoneTypeCAA, `0 issue "ca.example"`, ZoneRecordData{"value": "ca.example", "tag": "issue", "flags": float64(0)}),
+	)
+}
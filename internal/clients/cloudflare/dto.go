@@ -1,5 +1,13 @@
 package cloudflare
 
+type resultInfo struct {
+	Page       int `json:"page"`
+	PerPage    int `json:"per_page"`
+	TotalPages int `json:"total_pages"`
+	Count      int `json:"count"`
+	TotalCount int `json:"total_count"`
+}
+
 type GetZoneByDomainRequest struct {
 	Domain string
 }
@@ -8,10 +16,158 @@ type GetZoneByDomainResponse struct {
 	ZoneID string
 }
 
+// ZoneStatuses are the values accepted by the status filter of ListZones.
+var ZoneStatuses = []string{"initializing", "pending", "active", "moved"}
+
+type Zone struct {
+	ID                  string      `json:"id"`
+	Name                string      `json:"name"`
+	Status              string      `json:"status"`
+	Paused              bool        `json:"paused"`
+	Type                string      `json:"type"`
+	DevelopmentMode     int         `json:"development_mode"`
+	NameServers         []string    `json:"name_servers"`
+	OriginalNameServers []string    `json:"original_name_servers"`
+	OriginalRegistrar   string      `json:"original_registrar"`
+	OriginalDNSHost     string      `json:"original_dnshost"`
+	VanityNameServers   []string    `json:"vanity_name_servers,omitempty"`
+	Account             ZoneAccount `json:"account"`
+	Plan                ZonePlan    `json:"plan"`
+	Permissions         []string    `json:"permissions,omitempty"`
+	VerificationKey     string      `json:"verification_key,omitempty"`
+	CreatedOn           string      `json:"created_on"`
+	ModifiedOn          string      `json:"modified_on"`
+	ActivatedOn         string      `json:"activated_on"`
+}
+
+type ZoneAccount struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type ZonePlan struct {
+	ID           string  `json:"id"`
+	Name         string  `json:"name"`
+	Price        float64 `json:"price"`
+	Currency     string  `json:"currency"`
+	Frequency    string  `json:"frequency"`
+	LegacyID     string  `json:"legacy_id"`
+	IsSubscribed bool    `json:"is_subscribed"`
+}
+
+// ListZonesRequest filters the zones the credentials can access, empty fields match every zone.
+// Name accepts the operators of the API, eg. "contains:example".
+type ListZonesRequest struct {
+	Name        string
+	Status      string
+	AccountID   string
+	AccountName string
+}
+
+type ListZonesResponse struct {
+	Zones []Zone `json:"result"`
+}
+
+type GetZoneRequest struct {
+	ZoneID string
+}
+
+type GetZoneResponse struct {
+	Zone Zone `json:"result"`
+}
+
+type CreateZoneRequest struct {
+	Name      string
+	AccountID string
+	Type      ZoneSetup
+}
+
+type CreateZoneResponse struct {
+	Zone Zone `json:"result"`
+}
+
+// PatchZoneRequest edits the fields of a zone that are not nil.
+type PatchZoneRequest struct {
+	ZoneID string `json:"-"`
+	Paused *bool  `json:"paused,omitempty"`
+}
+
+type PatchZoneResponse struct {
+	Zone Zone `json:"result"`
+}
+
+type DeleteZoneRequest struct {
+	ZoneID string
+}
+
+type ZoneActivationCheckRequest struct {
+	ZoneID string
+}
+
+type ZoneSetting struct {
+	ID         string      `json:"id"`
+	Value      interface{} `json:"value"`
+	Editable   bool        `json:"editable"`
+	ModifiedOn string      `json:"modified_on,omitempty"`
+	// TimeRemaining is the number of seconds until development mode is turned off.
+	TimeRemaining int `json:"time_remaining,omitempty"`
+}
+
+type ListZoneSettingsRequest struct {
+	ZoneID string
+}
+
+type ListZoneSettingsResponse struct {
+	Settings []ZoneSetting `json:"result"`
+}
+
+type GetZoneSettingRequest struct {
+	ZoneID    string
+	SettingID string
+}
+
+type GetZoneSettingResponse struct {
+	Setting ZoneSetting `json:"result"`
+}
+
+// UpdateZoneSettingRequest sets a setting to a value, use ParseZoneSettingValue to build it from user input.
+type UpdateZoneSettingRequest struct {
+	ZoneID    string      `json:"-"`
+	SettingID string      `json:"-"`
+	Value     interface{} `json:"value"`
+}
+
+type UpdateZoneSettingResponse struct {
+	Setting ZoneSetting `json:"result"`
+}
+
+// PurgeCacheBatchSize is the most URLs, tags, hosts or prefixes a single purge request accepts.
+const PurgeCacheBatchSize = 30
+
+// PurgeCacheRequest purges everything, or the cache matching one of the lists. The API accepts a single kind
+// of purge per request, of at most PurgeCacheBatchSize items.
+type PurgeCacheRequest struct {
+	ZoneID          string   `json:"-"`
+	PurgeEverything bool     `json:"purge_everything,omitempty"`
+	Files           []string `json:"files,omitempty"`
+	Tags            []string `json:"tags,omitempty"`
+	Hosts           []string `json:"hosts,omitempty"`
+	Prefixes        []string `json:"prefixes,omitempty"`
+}
+
+type CachePurge struct {
+	ID string `json:"id"`
+}
+
+type PurgeCacheResponse struct {
+	Purge CachePurge `json:"result"`
+}
+
 type GetZoneRecordsRequest struct {
-	ZoneID string   `json:"-"`
-	Name   string   `json:"name,omitempty"`
-	Type   ZoneType `json:"type,omitempty"`
+	ZoneID  string   `json:"-"`
+	Name    string   `json:"name,omitempty"`
+	Type    ZoneType `json:"type,omitempty"`
+	Content string   `json:"content,omitempty"`
 }
 
 type ZoneRecord struct {
@@ -19,6 +175,8 @@ type ZoneRecord struct {
 	Name       string                 `json:"name"`
 	Type       ZoneType               `json:"type"`
 	Content    string                 `json:"content"`
+	Priority   *int                   `json:"priority,omitempty"`
+	Data       ZoneRecordData         `json:"data,omitempty"`
 	Proxied    bool                   `json:"proxied"`
 	Proxiable  bool                   `json:"proxiable"`
 	TTL        int                    `json:"ttl"`
@@ -32,19 +190,41 @@ type ZoneRecord struct {
 	ModifiedOn string                 `json:"modified_on"`
 }
 
+// DisplayPriority returns the priority of MX, URI and SRV records, reading it from data for SRV, or nil when the record has none.
+func (r ZoneRecord) DisplayPriority() interface{} {
+	if r.Priority != nil {
+		return *r.Priority
+	}
+	if priority, ok := r.Data["priority"]; ok {
+		return priority
+	}
+	return nil
+}
+
 type GetZoneRecordsResponse struct {
 	Records []ZoneRecord `json:"result"`
 }
 
+type GetZoneRecordRequest struct {
+	ZoneID   string
+	RecordID string
+}
+
+type GetZoneRecordResponse struct {
+	Record ZoneRecord `json:"result"`
+}
+
 type ZoneRecordRequest struct {
-	ID      string   `json:"id,omitempty"`
-	Type    ZoneType `json:"type"`
-	Name    string   `json:"name"`
-	Content string   `json:"content"`
-	Proxied bool     `json:"proxied"`
-	TTL     int      `json:"ttl"`
-	Tags    []string `json:"tags"`
-	Comment string   `json:"comment"`
+	ID       string         `json:"id,omitempty"`
+	Type     ZoneType       `json:"type"`
+	Name     string         `json:"name"`
+	Content  string         `json:"content"`
+	Priority *int           `json:"priority,omitempty"`
+	Data     ZoneRecordData `json:"data,omitempty"`
+	Proxied  bool           `json:"proxied"`
+	TTL      int            `json:"ttl"`
+	Tags     []string       `json:"tags"`
+	Comment  string         `json:"comment"`
 }
 
 type AddZoneRecordRequest struct {
@@ -62,6 +242,143 @@ type DeleteZoneRecordRequest struct {
 }
 
 type UpdateZoneRecordRequest struct {
-	ZoneID string
-	Record ZoneRecord
+	ZoneID   string
+	RecordID string
+	Record   ZoneRecordRequest
+}
+
+type UpdateZoneRecordResponse struct {
+	Record ZoneRecord `json:"result"`
+}
+
+type ZoneRecordPatch struct {
+	Type     *ZoneType      `json:"type,omitempty"`
+	Name     *string        `json:"name,omitempty"`
+	Content  *string        `json:"content,omitempty"`
+	Priority *int           `json:"priority,omitempty"`
+	Data     ZoneRecordData `json:"data,omitempty"`
+	Proxied  *bool          `json:"proxied,omitempty"`
+	TTL      *int           `json:"ttl,omitempty"`
+	Tags     *[]string      `json:"tags,omitempty"`
+	Comment  *string        `json:"comment,omitempty"`
+}
+
+// ApplyTo returns the full record that results from applying the patch to record.
+func (p ZoneRecordPatch) ApplyTo(record ZoneRecord) ZoneRecordRequest {
+	request := ZoneRecordRequest{
+		ID:       record.ID,
+		Type:     record.Type,
+		Name:     record.Name,
+		Content:  record.Content,
+		Priority: record.Priority,
+		Data:     record.Data,
+		Proxied:  record.Proxied,
+		TTL:      record.TTL,
+		Tags:     record.Tags,
+		Comment:  record.Comment,
+	}
+	if p.Type != nil {
+		request.Type = *p.Type
+	}
+	if p.Name != nil {
+		request.Name = *p.Name
+	}
+	if p.Content != nil {
+		request.Content = *p.Content
+	}
+	if p.Priority != nil {
+		request.Priority = p.Priority
+	}
+	if p.Data != nil {
+		request.Data = p.Data
+	}
+	if p.Proxied != nil {
+		request.Proxied = *p.Proxied
+	}
+	if p.TTL != nil {
+		request.TTL = *p.TTL
+	}
+	if p.Tags != nil {
+		request.Tags = *p.Tags
+	}
+	if p.Comment != nil {
+		request.Comment = *p.Comment
+	}
+	return request
+}
+
+type PatchZoneRecordRequest struct {
+	ZoneID   string
+	RecordID string
+	Record   ZoneRecordPatch
+}
+
+type PatchZoneRecordResponse struct {
+	Record ZoneRecord `json:"result"`
+}
+
+type TokenVerification struct {
+	ID        string `json:"id"`
+	Status    string `json:"status"`
+	NotBefore string `json:"not_before,omitempty"`
+	ExpiresOn string `json:"expires_on,omitempty"`
+}
+
+type VerifyTokenResponse struct {
+	Token TokenVerification `json:"result"`
+}
+
+type GetTokenRequest struct {
+	TokenID string
+}
+
+type Token struct {
+	ID         string         `json:"id"`
+	Name       string         `json:"name"`
+	Status     string         `json:"status"`
+	IssuedOn   string         `json:"issued_on,omitempty"`
+	ModifiedOn string         `json:"modified_on,omitempty"`
+	LastUsedOn string         `json:"last_used_on,omitempty"`
+	NotBefore  string         `json:"not_before,omitempty"`
+	ExpiresOn  string         `json:"expires_on,omitempty"`
+	Condition  TokenCondition `json:"condition"`
+	Policies   []TokenPolicy  `json:"policies"`
+}
+
+type TokenCondition struct {
+	RequestIP *TokenIPCondition `json:"request_ip,omitempty"`
+}
+
+// TokenIPCondition restricts the addresses a token can be used from, as CIDR ranges.
+type TokenIPCondition struct {
+	In    []string `json:"in,omitempty"`
+	NotIn []string `json:"not_in,omitempty"`
+}
+
+type TokenPolicy struct {
+	ID               string                 `json:"id"`
+	Effect           string                 `json:"effect"`
+	Resources        map[string]interface{} `json:"resources"`
+	PermissionGroups []TokenPermissionGroup `json:"permission_groups"`
+}
+
+type TokenPermissionGroup struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type GetTokenResponse struct {
+	Token Token `json:"result"`
+}
+
+type User struct {
+	ID        string `json:"id"`
+	Email     string `json:"email"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Suspended bool   `json:"suspended"`
+}
+
+type GetUserResponse struct {
+	User User `json:"result"`
 }
@@ -3,10 +3,31 @@ package cloudflare
 import "errors"
 
 var (
-	ErrZoneNotFound       = errors.New("zone not found")
-	ErrRecordNotFound     = errors.New("record not found")
-	ErrRecordDeleteFailed = errors.New("record delete failed")
-	ErrRecordAddFailed    = errors.New("record add failed")
-	ErrZoneListFailed     = errors.New("zone list failed")
-	ErrZoneRecordsFailed  = errors.New("zone records failed")
+	ErrZoneNotFound        = errors.New("zone not found")
+	ErrRecordNotFound      = errors.New("record not found")
+	ErrRecordDeleteFailed  = errors.New("record delete failed")
+	ErrRecordAddFailed     = errors.New("record add failed")
+	ErrRecordUpdateFailed  = errors.New("record update failed")
+	ErrZoneListFailed      = errors.New("zone list failed")
+	ErrZoneRecordsFailed   = errors.New("zone records failed")
+	ErrZoneDetailsFailed   = errors.New("zone details failed")
+	ErrZoneCreateFailed    = errors.New("zone create failed")
+	ErrZoneUpdateFailed    = errors.New("zone update failed")
+	ErrZoneDeleteFailed    = errors.New("zone delete failed")
+	ErrActivationFailed    = errors.New("zone activation check failed")
+	ErrInvalidZoneSetup    = errors.New("invalid zone setup type")
+	ErrZoneSettingsFailed  = errors.New("zone settings failed")
+	ErrSettingNotFound     = errors.New("zone setting not found")
+	ErrSettingUpdateFailed = errors.New("zone setting update failed")
+	ErrInvalidZoneSetting  = errors.New("invalid zone setting")
+	ErrCachePurgeFailed    = errors.New("cache purge failed")
+	ErrInvalidRecord       = errors.New("invalid record")
+	ErrUnauthorized        = errors.New("unauthorized")
+	ErrForbidden           = errors.New("forbidden")
+	ErrRateLimited         = errors.New("rate limited")
+	ErrInvalidAuthMethod   = errors.New("invalid auth method")
+	ErrTokenVerifyFailed   = errors.New("token verify failed")
+	ErrTokenNotFound       = errors.New("token not found")
+	ErrTokenDetailsFailed  = errors.New("token details failed")
+	ErrUserDetailsFailed   = errors.New("user details failed")
 )
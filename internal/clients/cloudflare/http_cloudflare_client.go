@@ -7,12 +7,33 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strconv"
 )
 
 type httpCloudflareClient struct {
-	client  *http.Client
-	apiKey  string
-	baseUrl string
+	client      *http.Client
+	credentials Credentials
+	baseUrl     string
+	retryPolicy RetryPolicy
+	limiter     *RateLimiter
+}
+
+// Option configures optional behaviour of the HTTP client.
+type Option func(*httpCloudflareClient)
+
+// WithRetryPolicy retries transient failures, by default requests are sent once.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(h *httpCloudflareClient) {
+		h.retryPolicy = policy
+	}
+}
+
+// WithRateLimiter throttles every request sent by the client through limiter.
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(h *httpCloudflareClient) {
+		h.limiter = limiter
+	}
 }
 
 func (h httpCloudflareClient) acquireRequest(ctx context.Context, method, url string, body io.Reader) (*http.Request, error) {
@@ -21,62 +42,128 @@ func (h httpCloudflareClient) acquireRequest(ctx context.Context, method, url st
 		return nil, err
 	}
 
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", h.apiKey))
+	h.credentials.apply(req.Header)
 	req.Header.Set("Content-Type", "application/json")
 
 	return req, nil
 }
 
+// acquireResponseError parses a failed response into an *APIError wrapping wrap.
 func (h httpCloudflareClient) acquireResponseError(resp *http.Response, wrap error) error {
-	if resp.StatusCode == http.StatusForbidden {
-		return fmt.Errorf("%w: UNAUTHORIZED. You might need to check your API key", wrap)
-	}
-
-	errorMessage, err := io.ReadAll(resp.Body)
+	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return fmt.Errorf("%w: %s", wrap, err.Error())
 	}
 
-	return fmt.Errorf("%w: unexpected status code: %d - %s", wrap, resp.StatusCode, string(errorMessage))
+	return newAPIError(resp.StatusCode, body, wrap)
 }
 
-func (h httpCloudflareClient) GetZoneByDomain(ctx context.Context, request GetZoneByDomainRequest) (*GetZoneByDomainResponse, error) {
-	requestUrl := fmt.Sprintf("%s/client/v4/zones", h.baseUrl)
-	req, err := h.acquireRequest(ctx, http.MethodGet, requestUrl, nil)
-	if err != nil {
-		return nil, fmt.Errorf("%w: %s", ErrZoneListFailed, err.Error())
+// acquireAllPages walks every page of a paginated list endpoint and returns the concatenated results.
+func acquireAllPages[T any](ctx context.Context, h httpCloudflareClient, requestUrl string, query url.Values, wrap error) ([]T, error) {
+	type pageResponse struct {
+		Result     []T        `json:"result"`
+		ResultInfo resultInfo `json:"result_info"`
+	}
+
+	results := []T{}
+	for page := 1; ; page++ {
+		req, err := h.acquireRequest(ctx, http.MethodGet, requestUrl, nil)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", wrap, err.Error())
+		}
+
+		q := req.URL.Query()
+		for key, values := range query {
+			for _, value := range values {
+				q.Add(key, value)
+			}
+		}
+		q.Set("page", strconv.Itoa(page))
+		q.Set("per_page", strconv.Itoa(defaultPerPage))
+		req.URL.RawQuery = q.Encode()
+
+		resp, err := h.do(req)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", wrap, err.Error())
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			defer resp.Body.Close()
+			if resp.StatusCode == http.StatusNotFound {
+				return nil, ErrZoneNotFound
+			}
+
+			return nil, h.acquireResponseError(resp, wrap)
+		}
+
+		var response pageResponse
+		err = json.NewDecoder(resp.Body).Decode(&response)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, response.Result...)
+		if page >= response.ResultInfo.TotalPages {
+			return results, nil
+		}
 	}
+}
 
-	q := req.URL.Query()
-	q.Add("name", request.Domain)
-	req.URL.RawQuery = q.Encode()
+// acquireResult sends a request to an endpoint answering with a single object and decodes its result.
+// A 404 is reported as notFound, or as any other failure when notFound is nil.
+func acquireResult[T any](ctx context.Context, h httpCloudflareClient, method, requestUrl string, body interface{}, wrap, notFound error) (*T, error) {
+	var payload io.Reader
+	if body != nil {
+		var buffer bytes.Buffer
+		if err := json.NewEncoder(&buffer).Encode(body); err != nil {
+			return nil, fmt.Errorf("%w: %s", wrap, err.Error())
+		}
+		payload = &buffer
+	}
+
+	req, err := h.acquireRequest(ctx, method, requestUrl, payload)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", wrap, err.Error())
+	}
 
-	resp, err := h.client.Do(req)
+	resp, err := h.do(req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %s", ErrZoneListFailed, err.Error())
+		return nil, fmt.Errorf("%w: %s", wrap, err.Error())
 	}
 
 	defer resp.Body.Close()
 	if resp.StatusCode != http.StatusOK {
-		if resp.StatusCode == http.StatusNotFound {
-			return nil, ErrZoneNotFound
+		if resp.StatusCode == http.StatusNotFound && notFound != nil {
+			return nil, notFound
 		}
 
-		return nil, h.acquireResponseError(resp, ErrZoneListFailed)
+		return nil, h.acquireResponseError(resp, wrap)
 	}
 
-	type listZonesResponse struct {
-		Result []struct {
-			ID   string `json:"id"`
-			Name string `json:"name"`
-		}
-	}
-	var response listZonesResponse
+	var response T
 	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("%w: %s", wrap, err.Error())
+	}
+
+	return &response, nil
+}
+
+func (h httpCloudflareClient) GetZoneByDomain(ctx context.Context, request GetZoneByDomainRequest) (*GetZoneByDomainResponse, error) {
+	requestUrl := fmt.Sprintf("%s/client/v4/zones", h.baseUrl)
+	query := url.Values{}
+	query.Add("name", request.Domain)
+
+	type zone struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+	zones, err := acquireAllPages[zone](ctx, h, requestUrl, query, ErrZoneListFailed)
+	if err != nil {
 		return nil, err
 	}
 
-	for _, zone := range response.Result {
+	for _, zone := range zones {
 		if zone.Name == request.Domain {
 			return &GetZoneByDomainResponse{ZoneID: zone.ID}, nil
 		}
@@ -85,23 +172,124 @@ func (h httpCloudflareClient) GetZoneByDomain(ctx context.Context, request GetZo
 	return nil, ErrZoneNotFound
 }
 
+func (h httpCloudflareClient) ListZones(ctx context.Context, request ListZonesRequest) (*ListZonesResponse, error) {
+	requestUrl := fmt.Sprintf("%s/client/v4/zones", h.baseUrl)
+	query := url.Values{}
+	if request.Name != "" {
+		query.Add("name", request.Name)
+	}
+	if request.Status != "" {
+		query.Add("status", request.Status)
+	}
+	if request.AccountID != "" {
+		query.Add("account.id", request.AccountID)
+	}
+	if request.AccountName != "" {
+		query.Add("account.name", request.AccountName)
+	}
+
+	zones, err := acquireAllPages[Zone](ctx, h, requestUrl, query, ErrZoneListFailed)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListZonesResponse{Zones: zones}, nil
+}
+
+func (h httpCloudflareClient) GetZone(ctx context.Context, request GetZoneRequest) (*GetZoneResponse, error) {
+	requestUrl := fmt.Sprintf("%s/client/v4/zones/%s", h.baseUrl, request.ZoneID)
+	return acquireResult[GetZoneResponse](ctx, h, http.MethodGet, requestUrl, nil, ErrZoneDetailsFailed, ErrZoneNotFound)
+}
+
+func (h httpCloudflareClient) CreateZone(ctx context.Context, request CreateZoneRequest) (*CreateZoneResponse, error) {
+	requestUrl := fmt.Sprintf("%s/client/v4/zones", h.baseUrl)
+	body := map[string]interface{}{
+		"name":    request.Name,
+		"account": map[string]string{"id": request.AccountID},
+		"type":    request.Type,
+	}
+	return acquireResult[CreateZoneResponse](ctx, h, http.MethodPost, requestUrl, body, ErrZoneCreateFailed, ErrZoneCreateFailed)
+}
+
+func (h httpCloudflareClient) PatchZone(ctx context.Context, request PatchZoneRequest) (*PatchZoneResponse, error) {
+	requestUrl := fmt.Sprintf("%s/client/v4/zones/%s", h.baseUrl, request.ZoneID)
+	return acquireResult[PatchZoneResponse](ctx, h, http.MethodPatch, requestUrl, request, ErrZoneUpdateFailed, ErrZoneNotFound)
+}
+
+func (h httpCloudflareClient) DeleteZone(ctx context.Context, request DeleteZoneRequest) error {
+	requestUrl := fmt.Sprintf("%s/client/v4/zones/%s", h.baseUrl, request.ZoneID)
+	_, err := acquireResult[struct{}](ctx, h, http.MethodDelete, requestUrl, nil, ErrZoneDeleteFailed, ErrZoneNotFound)
+	return err
+}
+
+func (h httpCloudflareClient) ZoneActivationCheck(ctx context.Context, request ZoneActivationCheckRequest) error {
+	requestUrl := fmt.Sprintf("%s/client/v4/zones/%s/activation_check", h.baseUrl, request.ZoneID)
+	_, err := acquireResult[struct{}](ctx, h, http.MethodPut, requestUrl, nil, ErrActivationFailed, ErrZoneNotFound)
+	return err
+}
+
+func (h httpCloudflareClient) ListZoneSettings(ctx context.Context, request ListZoneSettingsRequest) (*ListZoneSettingsResponse, error) {
+	requestUrl := fmt.Sprintf("%s/client/v4/zones/%s/settings", h.baseUrl, request.ZoneID)
+	return acquireResult[ListZoneSettingsResponse](ctx, h, http.MethodGet, requestUrl, nil, ErrZoneSettingsFailed, ErrZoneNotFound)
+}
+
+func (h httpCloudflareClient) GetZoneSetting(ctx context.Context, request GetZoneSettingRequest) (*GetZoneSettingResponse, error) {
+	requestUrl := fmt.Sprintf("%s/client/v4/zones/%s/settings/%s", h.baseUrl, request.ZoneID, request.SettingID)
+	return acquireResult[GetZoneSettingResponse](ctx, h, http.MethodGet, requestUrl, nil, ErrZoneSettingsFailed, ErrSettingNotFound)
+}
+
+func (h httpCloudflareClient) UpdateZoneSetting(ctx context.Context, request UpdateZoneSettingRequest) (*UpdateZoneSettingResponse, error) {
+	requestUrl := fmt.Sprintf("%s/client/v4/zones/%s/settings/%s", h.baseUrl, request.ZoneID, request.SettingID)
+	return acquireResult[UpdateZoneSettingResponse](ctx, h, http.MethodPatch, requestUrl, request, ErrSettingUpdateFailed, ErrSettingNotFound)
+}
+
+func (h httpCloudflareClient) PurgeCache(ctx context.Context, request PurgeCacheRequest) (*PurgeCacheResponse, error) {
+	requestUrl := fmt.Sprintf("%s/client/v4/zones/%s/purge_cache", h.baseUrl, request.ZoneID)
+	return acquireResult[PurgeCacheResponse](ctx, h, http.MethodPost, requestUrl, request, ErrCachePurgeFailed, ErrZoneNotFound)
+}
+
 func (h httpCloudflareClient) GetZoneRecords(ctx context.Context, request GetZoneRecordsRequest) (*GetZoneRecordsResponse, error) {
 	requestUrl := fmt.Sprintf("%s/client/v4/zones/%s/dns_records", h.baseUrl, request.ZoneID)
-	req, err := h.acquireRequest(ctx, http.MethodGet, requestUrl, nil)
+	query := url.Values{}
+	if request.Name != "" {
+		query.Add("name", request.Name)
+	}
+	if request.Type != "" {
+		query.Add("type", string(request.Type))
+	}
+	if request.Content != "" {
+		query.Add("content", request.Content)
+	}
+
+	records, err := acquireAllPages[ZoneRecord](ctx, h, requestUrl, query, ErrZoneRecordsFailed)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %s", ErrZoneRecordsFailed, err.Error())
+		return nil, err
 	}
-	q := req.URL.Query()
 
-	q.Add("per_page", "50")
-	if request.Name != "" {
-		q.Add("name", request.Name)
+	return &GetZoneRecordsResponse{Records: records}, nil
+}
+
+func (h httpCloudflareClient) GetZoneRecord(ctx context.Context, request GetZoneRecordRequest) (*GetZoneRecordResponse, error) {
+	requestUrl := fmt.Sprintf("%s/client/v4/zones/%s/dns_records/%s", h.baseUrl, request.ZoneID, request.RecordID)
+	return acquireResult[GetZoneRecordResponse](ctx, h, http.MethodGet, requestUrl, nil, ErrZoneRecordsFailed, ErrRecordNotFound)
+}
+
+func (h httpCloudflareClient) AddZoneRecord(ctx context.Context, request AddZoneRecordRequest) (*AddZoneRecordResponse, error) {
+	requestUrl := fmt.Sprintf("%s/client/v4/zones/%s/dns_records", h.baseUrl, request.ZoneID)
+	var body bytes.Buffer
+	err := json.NewEncoder(&body).Encode(request.Record)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrRecordAddFailed, err.Error())
+	}
+
+	req, err := h.acquireRequest(ctx, http.MethodPost, requestUrl, &body)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrRecordAddFailed, err.Error())
 	}
 
-	req.URL.RawQuery = q.Encode()
-	resp, err := h.client.Do(req)
+	resp, err := h.do(req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %s", ErrZoneRecordsFailed, err.Error())
+		return nil, fmt.Errorf("%w: %s", ErrRecordAddFailed, err.Error())
 	}
 
 	defer resp.Body.Close()
@@ -110,56 +298,80 @@ func (h httpCloudflareClient) GetZoneRecords(ctx context.Context, request GetZon
 			return nil, ErrZoneNotFound
 		}
 
-		return nil, h.acquireResponseError(resp, ErrZoneRecordsFailed)
+		return nil, h.acquireResponseError(resp, ErrRecordAddFailed)
 	}
 
-	var response GetZoneRecordsResponse
+	var response AddZoneRecordResponse
 	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
 		return nil, err
 	}
 
-	if request.Type != "" {
-		var filteredRecords []ZoneRecord
-		for _, record := range response.Records {
-			if record.Type == request.Type {
-				filteredRecords = append(filteredRecords, record)
-			}
+	return &response, nil
+}
+
+func (h httpCloudflareClient) UpdateZoneRecord(ctx context.Context, request UpdateZoneRecordRequest) (*UpdateZoneRecordResponse, error) {
+	requestUrl := fmt.Sprintf("%s/client/v4/zones/%s/dns_records/%s", h.baseUrl, request.ZoneID, request.RecordID)
+	var body bytes.Buffer
+	err := json.NewEncoder(&body).Encode(request.Record)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrRecordUpdateFailed, err.Error())
+	}
+
+	req, err := h.acquireRequest(ctx, http.MethodPut, requestUrl, &body)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrRecordUpdateFailed, err.Error())
+	}
+
+	resp, err := h.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrRecordUpdateFailed, err.Error())
+	}
+
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		if resp.StatusCode == http.StatusNotFound {
+			return nil, ErrRecordNotFound
 		}
 
-		response.Records = filteredRecords
+		return nil, h.acquireResponseError(resp, ErrRecordUpdateFailed)
+	}
+
+	var response UpdateZoneRecordResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, err
 	}
 
 	return &response, nil
 }
 
-func (h httpCloudflareClient) AddZoneRecord(ctx context.Context, request AddZoneRecordRequest) (*AddZoneRecordResponse, error) {
-	requestUrl := fmt.Sprintf("%s/client/v4/zones/%s/dns_records", h.baseUrl, request.ZoneID)
+func (h httpCloudflareClient) PatchZoneRecord(ctx context.Context, request PatchZoneRecordRequest) (*PatchZoneRecordResponse, error) {
+	requestUrl := fmt.Sprintf("%s/client/v4/zones/%s/dns_records/%s", h.baseUrl, request.ZoneID, request.RecordID)
 	var body bytes.Buffer
 	err := json.NewEncoder(&body).Encode(request.Record)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %s", ErrRecordAddFailed, err.Error())
+		return nil, fmt.Errorf("%w: %s", ErrRecordUpdateFailed, err.Error())
 	}
 
-	req, err := h.acquireRequest(ctx, http.MethodPost, requestUrl, &body)
+	req, err := h.acquireRequest(ctx, http.MethodPatch, requestUrl, &body)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %s", ErrRecordAddFailed, err.Error())
+		return nil, fmt.Errorf("%w: %s", ErrRecordUpdateFailed, err.Error())
 	}
 
-	resp, err := h.client.Do(req)
+	resp, err := h.do(req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %s", ErrRecordAddFailed, err.Error())
+		return nil, fmt.Errorf("%w: %s", ErrRecordUpdateFailed, err.Error())
 	}
 
 	defer resp.Body.Close()
 	if resp.StatusCode != http.StatusOK {
 		if resp.StatusCode == http.StatusNotFound {
-			return nil, ErrZoneNotFound
+			return nil, ErrRecordNotFound
 		}
 
-		return nil, h.acquireResponseError(resp, ErrRecordAddFailed)
+		return nil, h.acquireResponseError(resp, ErrRecordUpdateFailed)
 	}
 
-	var response AddZoneRecordResponse
+	var response PatchZoneRecordResponse
 	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
 		return nil, err
 	}
@@ -174,7 +386,7 @@ func (h httpCloudflareClient) DeleteZoneRecord(ctx context.Context, request Dele
 		return fmt.Errorf("%w: %s", ErrRecordDeleteFailed, err.Error())
 	}
 
-	resp, err := h.client.Do(req)
+	resp, err := h.do(req)
 	if err != nil {
 		return fmt.Errorf("%w: %s", ErrRecordDeleteFailed, err.Error())
 	}
@@ -185,16 +397,35 @@ func (h httpCloudflareClient) DeleteZoneRecord(ctx context.Context, request Dele
 			return ErrRecordNotFound
 		}
 
-		return fmt.Errorf("%w: unexpected status code: %d", ErrRecordDeleteFailed, resp.StatusCode)
+		return h.acquireResponseError(resp, ErrRecordDeleteFailed)
 	}
 
 	return nil
 }
 
-func NewHttpCloudflareClient(client *http.Client, apiKey, baseUrl string) CloudflareClient {
-	return &httpCloudflareClient{
-		client:  client,
-		apiKey:  apiKey,
-		baseUrl: baseUrl,
+func (h httpCloudflareClient) VerifyToken(ctx context.Context) (*VerifyTokenResponse, error) {
+	requestUrl := fmt.Sprintf("%s/client/v4/user/tokens/verify", h.baseUrl)
+	return acquireResult[VerifyTokenResponse](ctx, h, http.MethodGet, requestUrl, nil, ErrTokenVerifyFailed, ErrTokenNotFound)
+}
+
+func (h httpCloudflareClient) GetToken(ctx context.Context, request GetTokenRequest) (*GetTokenResponse, error) {
+	requestUrl := fmt.Sprintf("%s/client/v4/user/tokens/%s", h.baseUrl, request.TokenID)
+	return acquireResult[GetTokenResponse](ctx, h, http.MethodGet, requestUrl, nil, ErrTokenDetailsFailed, ErrTokenNotFound)
+}
+
+func (h httpCloudflareClient) GetUser(ctx context.Context) (*GetUserResponse, error) {
+	requestUrl := fmt.Sprintf("%s/client/v4/user", h.baseUrl)
+	return acquireResult[GetUserResponse](ctx, h, http.MethodGet, requestUrl, nil, ErrUserDetailsFailed, nil)
+}
+
+func NewHttpCloudflareClient(client *http.Client, credentials Credentials, baseUrl string, options ...Option) CloudflareClient {
+	h := &httpCloudflareClient{
+		client:      client,
+		credentials: credentials,
+		baseUrl:     baseUrl,
+	}
+	for _, option := range options {
+		option(h)
 	}
+	return h
 }
@@ -39,9 +39,9 @@ func newMockServer(configs ...mockServerConfig) *httptest.Server {
 
 func TestNewHttpCloudflareClient(t *testing.T) {
 	type args struct {
-		client  *http.Client
-		apiKey  string
-		baseUrl string
+		client      *http.Client
+		credentials Credentials
+		baseUrl     string
 	}
 	tests := []struct {
 		name string
@@ -51,21 +51,21 @@ func TestNewHttpCloudflareClient(t *testing.T) {
 		{
 			name: "success",
 			args: args{
-				client:  &http.Client{},
-				apiKey:  "api-key",
-				baseUrl: "http://localhost",
+				client:      &http.Client{},
+				credentials: APITokenCredentials("api-key"),
+				baseUrl:     "http://localhost",
 			},
 			want: &httpCloudflareClient{
-				client:  &http.Client{},
-				apiKey:  "api-key",
-				baseUrl: "http://localhost",
+				client:      &http.Client{},
+				credentials: APITokenCredentials("api-key"),
+				baseUrl:     "http://localhost",
 			},
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := NewHttpCloudflareClient(tt.args.client, tt.args.apiKey, tt.args.baseUrl); !reflect.DeepEqual(got, tt.want) {
+			if got := NewHttpCloudflareClient(tt.args.client, tt.args.credentials, tt.args.baseUrl); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("NewHttpCloudflareClient() = %v, want %v", got, tt.want)
 			}
 		})
@@ -119,7 +119,7 @@ func Test_httpCloudflareClient_AddZoneRecord(t *testing.T) {
 			args: defaultArgs,
 			want: nil,
 			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
-				return assert.ErrorIs(t, err, ErrRecordAddFailed)
+				return assert.ErrorIs(t, err, ErrRecordAddFailed) && assert.ErrorIs(t, err, ErrUnauthorized)
 			},
 		},
 		{
@@ -187,9 +187,9 @@ func Test_httpCloudflareClient_AddZoneRecord(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			server := tt.fields.server()
 			h := httpCloudflareClient{
-				client:  server.Client(),
-				apiKey:  tt.fields.apiKey,
-				baseUrl: server.URL,
+				client:      server.Client(),
+				credentials: APITokenCredentials(tt.fields.apiKey),
+				baseUrl:     server.URL,
 			}
 			got, err := h.AddZoneRecord(tt.args.ctx, tt.args.request)
 			if tt.wantErr(t, err) {
@@ -241,7 +241,7 @@ func Test_httpCloudflareClient_DeleteZoneRecord(t *testing.T) {
 			},
 			args: defaultArgs,
 			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
-				return assert.ErrorIs(t, err, ErrRecordDeleteFailed)
+				return assert.ErrorIs(t, err, ErrRecordDeleteFailed) && assert.ErrorIs(t, err, ErrUnauthorized)
 			},
 		},
 		{
@@ -295,9 +295,9 @@ func Test_httpCloudflareClient_DeleteZoneRecord(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			server := tt.fields.server()
 			h := httpCloudflareClient{
-				client:  server.Client(),
-				apiKey:  tt.fields.apiKey,
-				baseUrl: server.URL,
+				client:      server.Client(),
+				credentials: APITokenCredentials(tt.fields.apiKey),
+				baseUrl:     server.URL,
 			}
 			if err := h.DeleteZoneRecord(tt.args.ctx, tt.args.request); tt.wantErr(t, err) {
 				return
@@ -347,7 +347,7 @@ func Test_httpCloudflareClient_GetZoneByDomain(t *testing.T) {
 			},
 			want: nil,
 			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
-				return assert.ErrorIs(t, err, ErrZoneListFailed)
+				return assert.ErrorIs(t, err, ErrZoneListFailed) && assert.ErrorIs(t, err, ErrUnauthorized)
 			},
 		},
 		{
@@ -410,9 +410,9 @@ func Test_httpCloudflareClient_GetZoneByDomain(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			server := tt.fields.server()
 			h := httpCloudflareClient{
-				client:  server.Client(),
-				apiKey:  tt.fields.apiKey,
-				baseUrl: server.URL,
+				client:      server.Client(),
+				credentials: APITokenCredentials(tt.fields.apiKey),
+				baseUrl:     server.URL,
 			}
 			got, err := h.GetZoneByDomain(tt.args.ctx, tt.args.request)
 			if tt.wantErr(t, err) {
@@ -467,7 +467,7 @@ func Test_httpCloudflareClient_GetZoneRecords(t *testing.T) {
 			args: defaultArgs,
 			want: nil,
 			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
-				return assert.ErrorIs(t, err, ErrZoneRecordsFailed)
+				return assert.ErrorIs(t, err, ErrZoneRecordsFailed) && assert.ErrorIs(t, err, ErrUnauthorized)
 			},
 		},
 		{
@@ -520,16 +520,15 @@ func Test_httpCloudflareClient_GetZoneRecords(t *testing.T) {
 					return newMockServer(mockServerConfig{
 						path:   "/client/v4/zones/mock-zone-id/dns_records",
 						method: http.MethodGet,
+						assert: func(r *http.Request) {
+							assert.Equal(t, r.URL.Query().Get("type"), "A")
+						},
 						response: map[string]interface{}{
 							"result": []map[string]interface{}{
 								{
 									"id":   "record-id",
 									"type": "A",
 								},
-								{
-									"id":   "record-id-2",
-									"type": "CNAME",
-								},
 							},
 						},
 						statusCode: http.StatusOK,
@@ -551,6 +550,33 @@ func Test_httpCloudflareClient_GetZoneRecords(t *testing.T) {
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "it should filter by content",
+			fields: fields{
+				server: func() *httptest.Server {
+					return newMockServer(mockServerConfig{
+						path:   "/client/v4/zones/mock-zone-id/dns_records",
+						method: http.MethodGet,
+						assert: func(r *http.Request) {
+							assert.Equal(t, r.URL.Query().Get("content"), "192.0.2.1")
+						},
+						response: map[string]interface{}{
+							"result": []map[string]interface{}{},
+						},
+						statusCode: http.StatusOK,
+					})
+				},
+			},
+			args: func() args {
+				args := defaultArgs
+				args.request.Content = "192.0.2.1"
+				return args
+			}(),
+			want: &GetZoneRecordsResponse{
+				Records: []ZoneRecord{},
+			},
+			wantErr: assert.NoError,
+		},
 		{
 			name: "it should return all records",
 			fields: fields{
@@ -594,9 +620,9 @@ func Test_httpCloudflareClient_GetZoneRecords(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			server := tt.fields.server()
 			h := httpCloudflareClient{
-				client:  server.Client(),
-				apiKey:  tt.fields.apiKey,
-				baseUrl: server.URL,
+				client:      server.Client(),
+				credentials: APITokenCredentials(tt.fields.apiKey),
+				baseUrl:     server.URL,
 			}
 			got, err := h.GetZoneRecords(tt.args.ctx, tt.args.request)
 			if tt.wantErr(t, err) {
@@ -608,3 +634,907 @@ func Test_httpCloudflareClient_GetZoneRecords(t *testing.T) {
 		})
 	}
 }
+
+func Test_httpCloudflareClient_GetZoneRecord(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		response   interface{}
+		want       *GetZoneRecordResponse
+		wantErr    assert.ErrorAssertionFunc
+	}{
+		{
+			name:       "it should return the record",
+			statusCode: http.StatusOK,
+			response: map[string]interface{}{
+				"result": map[string]interface{}{"id": "record-id", "name": "www.example.com", "type": "A", "content": "192.0.2.1"},
+			},
+			want:    &GetZoneRecordResponse{Record: ZoneRecord{ID: "record-id", Name: "www.example.com", Type: ZoneTypeA, Content: "192.0.2.1"}},
+			wantErr: assert.NoError,
+		},
+		{
+			name:       "it should return a not found error",
+			statusCode: http.StatusNotFound,
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, ErrRecordNotFound)
+			},
+		},
+		{
+			name:       "it should return a records error",
+			statusCode: http.StatusInternalServerError,
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, ErrZoneRecordsFailed)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newMockServer(mockServerConfig{
+				path:       "/client/v4/zones/zone-id/dns_records/record-id",
+				method:     http.MethodGet,
+				statusCode: tt.statusCode,
+				response:   tt.response,
+			})
+			defer server.Close()
+
+			h := httpCloudflareClient{
+				client:      server.Client(),
+				credentials: APITokenCredentials("api-key"),
+				baseUrl:     server.URL,
+			}
+			got, err := h.GetZoneRecord(context.Background(), GetZoneRecordRequest{ZoneID: "zone-id", RecordID: "record-id"})
+			if !tt.wantErr(t, err) {
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_httpCloudflareClient_GetZoneRecords_Pagination(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/client/v4/zones/mock-zone-id/dns_records", r.URL.Path)
+		assert.Equal(t, "MX", r.URL.Query().Get("type"))
+
+		page := r.URL.Query().Get("page")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"result": []map[string]interface{}{
+				{"id": "record-" + page, "type": "MX"},
+			},
+			"result_info": map[string]interface{}{
+				"per_page":    1,
+				"total_pages": 3,
+			},
+		})
+	}))
+	defer server.Close()
+
+	h := httpCloudflareClient{
+		client:  server.Client(),
+		baseUrl: server.URL,
+	}
+	got, err := h.GetZoneRecords(context.Background(), GetZoneRecordsRequest{
+		ZoneID: "mock-zone-id",
+		Type:   ZoneTypeMX,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &GetZoneRecordsResponse{
+		Records: []ZoneRecord{
+			{ID: "record-1", Type: ZoneTypeMX},
+			{ID: "record-2", Type: ZoneTypeMX},
+			{ID: "record-3", Type: ZoneTypeMX},
+		},
+	}, got)
+}
+
+func Test_httpCloudflareClient_RateLimited(t *testing.T) {
+	server := newMockServer(mockServerConfig{
+		path:       "/client/v4/zones/mock-zone-id/dns_records/record-id",
+		method:     http.MethodDelete,
+		statusCode: http.StatusTooManyRequests,
+	})
+	defer server.Close()
+
+	h := httpCloudflareClient{
+		client:  server.Client(),
+		baseUrl: server.URL,
+	}
+	err := h.DeleteZoneRecord(context.Background(), DeleteZoneRecordRequest{
+		ZoneID:   "mock-zone-id",
+		RecordID: "record-id",
+	})
+	assert.ErrorIs(t, err, ErrRecordDeleteFailed)
+	assert.ErrorIs(t, err, ErrRateLimited)
+}
+
+func Test_httpCloudflareClient_UpdateZoneRecord(t *testing.T) {
+	type fields struct {
+		server func() *httptest.Server
+		apiKey string
+	}
+	type args struct {
+		ctx     context.Context
+		request UpdateZoneRecordRequest
+	}
+	defaultArgs := args{
+		ctx: context.Background(),
+		request: UpdateZoneRecordRequest{
+			ZoneID:   "zone-id",
+			RecordID: "record-id",
+			Record: ZoneRecordRequest{
+				Type:    "A",
+				Name:    "app.example.com",
+				Content: "1.1.1.1",
+			},
+		},
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *UpdateZoneRecordResponse
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "it should return a forbidden error",
+			fields: fields{
+				server: func() *httptest.Server {
+					return newMockServer(mockServerConfig{
+						path:   "/client/v4/zones/zone-id/dns_records/record-id",
+						method: http.MethodPut,
+						assert: func(r *http.Request) {
+							assert.Equal(t, r.Header.Get("Authorization"), "Bearer api-key")
+						},
+						statusCode: http.StatusForbidden,
+					})
+				},
+				apiKey: "api-key",
+			},
+			args: defaultArgs,
+			want: nil,
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, ErrRecordUpdateFailed) && assert.ErrorIs(t, err, ErrUnauthorized)
+			},
+		},
+		{
+			name: "it should return a not found error",
+			fields: fields{
+				server: func() *httptest.Server {
+					return newMockServer(mockServerConfig{
+						path:       "/client/v4/zones/zone-id/dns_records/record-id",
+						method:     http.MethodPut,
+						statusCode: http.StatusNotFound,
+					})
+				},
+			},
+			args: defaultArgs,
+			want: nil,
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, ErrRecordNotFound)
+			},
+		},
+		{
+			name: "it should update the record",
+			fields: fields{
+				server: func() *httptest.Server {
+					return newMockServer(mockServerConfig{
+						path:   "/client/v4/zones/zone-id/dns_records/record-id",
+						method: http.MethodPut,
+						assert: func(r *http.Request) {
+							var body map[string]interface{}
+							_ = json.NewDecoder(r.Body).Decode(&body)
+							assert.Equal(t, "1.1.1.1", body["content"])
+							assert.Equal(t, "app.example.com", body["name"])
+						},
+						response: map[string]interface{}{
+							"result": map[string]interface{}{
+								"id":      "record-id",
+								"content": "1.1.1.1",
+							},
+						},
+						statusCode: http.StatusOK,
+					})
+				},
+			},
+			args: defaultArgs,
+			want: &UpdateZoneRecordResponse{
+				Record: ZoneRecord{
+					ID:      "record-id",
+					Content: "1.1.1.1",
+				},
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := tt.fields.server()
+			h := httpCloudflareClient{
+				client:      server.Client(),
+				credentials: APITokenCredentials(tt.fields.apiKey),
+				baseUrl:     server.URL,
+			}
+			got, err := h.UpdateZoneRecord(tt.args.ctx, tt.args.request)
+			if tt.wantErr(t, err) {
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UpdateZoneRecord() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_httpCloudflareClient_PatchZoneRecord(t *testing.T) {
+	type fields struct {
+		server func() *httptest.Server
+		apiKey string
+	}
+	type args struct {
+		ctx     context.Context
+		request PatchZoneRecordRequest
+	}
+	content := "2.2.2.2"
+	defaultArgs := args{
+		ctx: context.Background(),
+		request: PatchZoneRecordRequest{
+			ZoneID:   "zone-id",
+			RecordID: "record-id",
+			Record: ZoneRecordPatch{
+				Content: &content,
+			},
+		},
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *PatchZoneRecordResponse
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "it should return a forbidden error",
+			fields: fields{
+				server: func() *httptest.Server {
+					return newMockServer(mockServerConfig{
+						path:   "/client/v4/zones/zone-id/dns_records/record-id",
+						method: http.MethodPatch,
+						assert: func(r *http.Request) {
+							assert.Equal(t, r.Header.Get("Authorization"), "Bearer api-key")
+						},
+						statusCode: http.StatusForbidden,
+					})
+				},
+				apiKey: "api-key",
+			},
+			args: defaultArgs,
+			want: nil,
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, ErrRecordUpdateFailed) && assert.ErrorIs(t, err, ErrUnauthorized)
+			},
+		},
+		{
+			name: "it should return a not found error",
+			fields: fields{
+				server: func() *httptest.Server {
+					return newMockServer(mockServerConfig{
+						path:       "/client/v4/zones/zone-id/dns_records/record-id",
+						method:     http.MethodPatch,
+						statusCode: http.StatusNotFound,
+					})
+				},
+			},
+			args: defaultArgs,
+			want: nil,
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, ErrRecordNotFound)
+			},
+		},
+		{
+			name: "it should only send the changed fields",
+			fields: fields{
+				server: func() *httptest.Server {
+					return newMockServer(mockServerConfig{
+						path:   "/client/v4/zones/zone-id/dns_records/record-id",
+						method: http.MethodPatch,
+						assert: func(r *http.Request) {
+							var body map[string]interface{}
+							_ = json.NewDecoder(r.Body).Decode(&body)
+							assert.Equal(t, map[string]interface{}{"content": "2.2.2.2"}, body)
+						},
+						response: map[string]interface{}{
+							"result": map[string]interface{}{
+								"id":      "record-id",
+								"content": "2.2.2.2",
+							},
+						},
+						statusCode: http.StatusOK,
+					})
+				},
+			},
+			args: defaultArgs,
+			want: &PatchZoneRecordResponse{
+				Record: ZoneRecord{
+					ID:      "record-id",
+					Content: "2.2.2.2",
+				},
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := tt.fields.server()
+			h := httpCloudflareClient{
+				client:      server.Client(),
+				credentials: APITokenCredentials(tt.fields.apiKey),
+				baseUrl:     server.URL,
+			}
+			got, err := h.PatchZoneRecord(tt.args.ctx, tt.args.request)
+			if tt.wantErr(t, err) {
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PatchZoneRecord() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_httpCloudflareClient_VerifyToken(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		response   interface{}
+		want       *VerifyTokenResponse
+		wantErr    assert.ErrorAssertionFunc
+	}{
+		{
+			name:       "it should return the token status",
+			statusCode: http.StatusOK,
+			response: map[string]interface{}{
+				"result": map[string]interface{}{
+					"id":         "token-id",
+					"status":     "active",
+					"expires_on": "2030-01-01T00:00:00Z",
+				},
+			},
+			want: &VerifyTokenResponse{
+				Token: TokenVerification{
+					ID:        "token-id",
+					Status:    "active",
+					ExpiresOn: "2030-01-01T00:00:00Z",
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:       "it should return an unauthorized error",
+			statusCode: http.StatusUnauthorized,
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, ErrTokenVerifyFailed) && assert.ErrorIs(t, err, ErrUnauthorized)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newMockServer(mockServerConfig{
+				path:       "/client/v4/user/tokens/verify",
+				method:     http.MethodGet,
+				statusCode: tt.statusCode,
+				response:   tt.response,
+				assert: func(r *http.Request) {
+					assert.Equal(t, "Bearer api-key", r.Header.Get("Authorization"))
+				},
+			})
+			defer server.Close()
+
+			h := httpCloudflareClient{
+				client:      server.Client(),
+				credentials: APITokenCredentials("api-key"),
+				baseUrl:     server.URL,
+			}
+			got, err := h.VerifyToken(context.Background())
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_httpCloudflareClient_GetToken(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		response   interface{}
+		want       *GetTokenResponse
+		wantErr    assert.ErrorAssertionFunc
+	}{
+		{
+			name:       "it should return the token details",
+			statusCode: http.StatusOK,
+			response: map[string]interface{}{
+				"result": map[string]interface{}{
+					"id":     "token-id",
+					"name":   "ci",
+					"status": "active",
+					"condition": map[string]interface{}{
+						"request_ip": map[string]interface{}{"in": []string{"192.0.2.0/24"}},
+					},
+					"policies": []map[string]interface{}{
+						{
+							"id":                "policy-id",
+							"effect":            "allow",
+							"resources":         map[string]interface{}{"com.cloudflare.api.account.zone.zone-id": "*"},
+							"permission_groups": []map[string]interface{}{{"id": "group-id", "name": "DNS Write"}},
+						},
+					},
+				},
+			},
+			want: &GetTokenResponse{
+				Token: Token{
+					ID:     "token-id",
+					Name:   "ci",
+					Status: "active",
+					Condition: TokenCondition{
+						RequestIP: &TokenIPCondition{In: []string{"192.0.2.0/24"}},
+					},
+					Policies: []TokenPolicy{
+						{
+							ID:               "policy-id",
+							Effect:           "allow",
+							Resources:        map[string]interface{}{"com.cloudflare.api.account.zone.zone-id": "*"},
+							PermissionGroups: []TokenPermissionGroup{{ID: "group-id", Name: "DNS Write"}},
+						},
+					},
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:       "it should return a not found error",
+			statusCode: http.StatusNotFound,
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, ErrTokenNotFound)
+			},
+		},
+		{
+			name:       "it should return a forbidden error",
+			statusCode: http.StatusForbidden,
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, ErrTokenDetailsFailed) && assert.ErrorIs(t, err, ErrUnauthorized)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newMockServer(mockServerConfig{
+				path:       "/client/v4/user/tokens/token-id",
+				method:     http.MethodGet,
+				statusCode: tt.statusCode,
+				response:   tt.response,
+			})
+			defer server.Close()
+
+			h := httpCloudflareClient{
+				client:  server.Client(),
+				baseUrl: server.URL,
+			}
+			got, err := h.GetToken(context.Background(), GetTokenRequest{TokenID: "token-id"})
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_httpCloudflareClient_GetUser(t *testing.T) {
+	server := newMockServer(mockServerConfig{
+		path:       "/client/v4/user",
+		method:     http.MethodGet,
+		statusCode: http.StatusOK,
+		response: map[string]interface{}{
+			"result": map[string]interface{}{"id": "user-id", "email": "user@example.com"},
+		},
+		assert: func(r *http.Request) {
+			assert.Equal(t, "user@example.com", r.Header.Get("X-Auth-Email"))
+			assert.Equal(t, "global-key", r.Header.Get("X-Auth-Key"))
+		},
+	})
+	defer server.Close()
+
+	h := httpCloudflareClient{
+		client:      server.Client(),
+		credentials: GlobalAPIKeyCredentials("user@example.com", "global-key"),
+		baseUrl:     server.URL,
+	}
+	got, err := h.GetUser(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, &GetUserResponse{User: User{ID: "user-id", Email: "user@example.com"}}, got)
+}
+
+func Test_httpCloudflareClient_GetUser_NotFound(t *testing.T) {
+	server := newMockServer()
+	defer server.Close()
+
+	h := httpCloudflareClient{
+		client:      server.Client(),
+		credentials: APITokenCredentials("api-key"),
+		baseUrl:     server.URL,
+	}
+	got, err := h.GetUser(context.Background())
+	assert.Nil(t, got)
+	assert.ErrorIs(t, err, ErrUserDetailsFailed)
+	var apiErr *APIError
+	assert.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+}
+
+func Test_httpCloudflareClient_ListZones(t *testing.T) {
+	server := newMockServer(mockServerConfig{
+		path:       "/client/v4/zones",
+		method:     http.MethodGet,
+		statusCode: http.StatusOK,
+		response: map[string]interface{}{
+			"result": []map[string]interface{}{
+				{
+					"id":           "zone-id",
+					"name":         "example.com",
+					"status":       "active",
+					"name_servers": []string{"ada.ns.cloudflare.com", "bob.ns.cloudflare.com"},
+					"account":      map[string]interface{}{"id": "account-id", "name": "Account"},
+					"plan":         map[string]interface{}{"id": "plan-id", "name": "Free Website"},
+				},
+			},
+			"result_info": map[string]interface{}{"page": 1, "total_pages": 1},
+		},
+		assert: func(r *http.Request) {
+			assert.Equal(t, "contains:example", r.URL.Query().Get("name"))
+			assert.Equal(t, "active", r.URL.Query().Get("status"))
+			assert.Equal(t, "account-id", r.URL.Query().Get("account.id"))
+			assert.False(t, r.URL.Query().Has("account.name"))
+		},
+	})
+	defer server.Close()
+
+	h := httpCloudflareClient{
+		client:      server.Client(),
+		credentials: APITokenCredentials("api-key"),
+		baseUrl:     server.URL,
+	}
+	got, err := h.ListZones(context.Background(), ListZonesRequest{
+		Name:      "contains:example",
+		Status:    "active",
+		AccountID: "account-id",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &ListZonesResponse{Zones: []Zone{
+		{
+			ID:          "zone-id",
+			Name:        "example.com",
+			Status:      "active",
+			NameServers: []string{"ada.ns.cloudflare.com", "bob.ns.cloudflare.com"},
+			Account:     ZoneAccount{ID: "account-id", Name: "Account"},
+			Plan:        ZonePlan{ID: "plan-id", Name: "Free Website"},
+		},
+	}}, got)
+}
+
+func Test_httpCloudflareClient_GetZone(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		response   interface{}
+		want       *GetZoneResponse
+		wantErr    assert.ErrorAssertionFunc
+	}{
+		{
+			name:       "it should return the zone",
+			statusCode: http.StatusOK,
+			response: map[string]interface{}{
+				"result": map[string]interface{}{"id": "zone-id", "name": "example.com", "paused": true},
+			},
+			want:    &GetZoneResponse{Zone: Zone{ID: "zone-id", Name: "example.com", Paused: true}},
+			wantErr: assert.NoError,
+		},
+		{
+			name:       "it should return a not found error",
+			statusCode: http.StatusNotFound,
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, ErrZoneNotFound)
+			},
+		},
+		{
+			name:       "it should return a details error",
+			statusCode: http.StatusInternalServerError,
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, ErrZoneDetailsFailed)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newMockServer(mockServerConfig{
+				path:       "/client/v4/zones/zone-id",
+				method:     http.MethodGet,
+				statusCode: tt.statusCode,
+				response:   tt.response,
+			})
+			defer server.Close()
+
+			h := httpCloudflareClient{
+				client:      server.Client(),
+				credentials: APITokenCredentials("api-key"),
+				baseUrl:     server.URL,
+			}
+			got, err := h.GetZone(context.Background(), GetZoneRequest{ZoneID: "zone-id"})
+			if !tt.wantErr(t, err) {
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_httpCloudflareClient_CreateZone(t *testing.T) {
+	server := newMockServer(mockServerConfig{
+		path:       "/client/v4/zones",
+		method:     http.MethodPost,
+		statusCode: http.StatusOK,
+		response: map[string]interface{}{
+			"result": map[string]interface{}{
+				"id":           "zone-id",
+				"name":         "example.com",
+				"status":       "pending",
+				"name_servers": []string{"ada.ns.cloudflare.com", "bob.ns.cloudflare.com"},
+			},
+		},
+		assert: func(r *http.Request) {
+			var body map[string]interface{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, map[string]interface{}{
+				"name":    "example.com",
+				"account": map[string]interface{}{"id": "account-id"},
+				"type":    "full",
+			}, body)
+		},
+	})
+	defer server.Close()
+
+	h := httpCloudflareClient{
+		client:      server.Client(),
+		credentials: APITokenCredentials("api-key"),
+		baseUrl:     server.URL,
+	}
+	got, err := h.CreateZone(context.Background(), CreateZoneRequest{
+		Name:      "example.com",
+		AccountID: "account-id",
+		Type:      ZoneSetupFull,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &CreateZoneResponse{Zone: Zone{
+		ID:          "zone-id",
+		Name:        "example.com",
+		Status:      "pending",
+		NameServers: []string{"ada.ns.cloudflare.com", "bob.ns.cloudflare.com"},
+	}}, got)
+}
+
+func Test_httpCloudflareClient_PatchZone(t *testing.T) {
+	server := newMockServer(mockServerConfig{
+		path:       "/client/v4/zones/zone-id",
+		method:     http.MethodPatch,
+		statusCode: http.StatusOK,
+		response: map[string]interface{}{
+			"result": map[string]interface{}{"id": "zone-id", "paused": true},
+		},
+		assert: func(r *http.Request) {
+			var body map[string]interface{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, map[string]interface{}{"paused": true}, body)
+		},
+	})
+	defer server.Close()
+
+	h := httpCloudflareClient{
+		client:      server.Client(),
+		credentials: APITokenCredentials("api-key"),
+		baseUrl:     server.URL,
+	}
+	paused := true
+	got, err := h.PatchZone(context.Background(), PatchZoneRequest{ZoneID: "zone-id", Paused: &paused})
+	assert.NoError(t, err)
+	assert.Equal(t, &PatchZoneResponse{Zone: Zone{ID: "zone-id", Paused: true}}, got)
+}
+
+func Test_httpCloudflareClient_DeleteZone(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		wantErr    assert.ErrorAssertionFunc
+	}{
+		{
+			name:       "it should delete the zone",
+			statusCode: http.StatusOK,
+			wantErr:    assert.NoError,
+		},
+		{
+			name:       "it should return a not found error",
+			statusCode: http.StatusNotFound,
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, ErrZoneNotFound)
+			},
+		},
+		{
+			name:       "it should return a delete error",
+			statusCode: http.StatusBadRequest,
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, ErrZoneDeleteFailed)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newMockServer(mockServerConfig{
+				path:       "/client/v4/zones/zone-id",
+				method:     http.MethodDelete,
+				statusCode: tt.statusCode,
+				response:   map[string]interface{}{"result": map[string]interface{}{"id": "zone-id"}},
+			})
+			defer server.Close()
+
+			h := httpCloudflareClient{
+				client:      server.Client(),
+				credentials: APITokenCredentials("api-key"),
+				baseUrl:     server.URL,
+			}
+			tt.wantErr(t, h.DeleteZone(context.Background(), DeleteZoneRequest{ZoneID: "zone-id"}))
+		})
+	}
+}
+
+func Test_httpCloudflareClient_ZoneActivationCheck(t *testing.T) {
+	server := newMockServer(mockServerConfig{
+		path:       "/client/v4/zones/zone-id/activation_check",
+		method:     http.MethodPut,
+		statusCode: http.StatusBadRequest,
+		response: map[string]interface{}{
+			"success": false,
+			"errors":  []map[string]interface{}{{"code": 1224, "message": "You may only perform this action once per hour."}},
+		},
+	})
+	defer server.Close()
+
+	h := httpCloudflareClient{
+		client:      server.Client(),
+		credentials: APITokenCredentials("api-key"),
+		baseUrl:     server.URL,
+	}
+	err := h.ZoneActivationCheck(context.Background(), ZoneActivationCheckRequest{ZoneID: "zone-id"})
+	assert.ErrorIs(t, err, ErrActivationFailed)
+	assert.ErrorContains(t, err, "code 1224")
+}
+
+func Test_httpCloudflareClient_ListZoneSettings(t *testing.T) {
+	server := newMockServer(mockServerConfig{
+		path:       "/client/v4/zones/zone-id/settings",
+		method:     http.MethodGet,
+		statusCode: http.StatusOK,
+		response: map[string]interface{}{
+			"result": []map[string]interface{}{
+				{"id": "ssl", "value": "full", "editable": true},
+				{"id": "browser_cache_ttl", "value": 14400, "editable": true},
+			},
+		},
+	})
+	defer server.Close()
+
+	h := httpCloudflareClient{
+		client:      server.Client(),
+		credentials: APITokenCredentials("api-key"),
+		baseUrl:     server.URL,
+	}
+	got, err := h.ListZoneSettings(context.Background(), ListZoneSettingsRequest{ZoneID: "zone-id"})
+	assert.NoError(t, err)
+	assert.Equal(t, &ListZoneSettingsResponse{Settings: []ZoneSetting{
+		{ID: "ssl", Value: "full", Editable: true},
+		{ID: "browser_cache_ttl", Value: float64(14400), Editable: true},
+	}}, got)
+}
+
+func Test_httpCloudflareClient_GetZoneSetting(t *testing.T) {
+	server := newMockServer(mockServerConfig{
+		path:       "/client/v4/zones/zone-id/settings/development_mode",
+		method:     http.MethodGet,
+		statusCode: http.StatusOK,
+		response: map[string]interface{}{
+			"result": map[string]interface{}{"id": "development_mode", "value": "on", "editable": true, "time_remaining": 3600},
+		},
+	})
+	defer server.Close()
+
+	h := httpCloudflareClient{
+		client:      server.Client(),
+		credentials: APITokenCredentials("api-key"),
+		baseUrl:     server.URL,
+	}
+	got, err := h.GetZoneSetting(context.Background(), GetZoneSettingRequest{ZoneID: "zone-id", SettingID: "development_mode"})
+	assert.NoError(t, err)
+	assert.Equal(t, &GetZoneSettingResponse{Setting: ZoneSetting{ID: "development_mode", Value: "on", Editable: true, TimeRemaining: 3600}}, got)
+
+	_, err = h.GetZoneSetting(context.Background(), GetZoneSettingRequest{ZoneID: "zone-id", SettingID: "bogus"})
+	assert.ErrorIs(t, err, ErrSettingNotFound)
+}
+
+func Test_httpCloudflareClient_UpdateZoneSetting(t *testing.T) {
+	server := newMockServer(mockServerConfig{
+		path:       "/client/v4/zones/zone-id/settings/browser_cache_ttl",
+		method:     http.MethodPatch,
+		statusCode: http.StatusOK,
+		response: map[string]interface{}{
+			"result": map[string]interface{}{"id": "browser_cache_ttl", "value": 14400, "editable": true},
+		},
+		assert: func(r *http.Request) {
+			var body map[string]interface{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, map[string]interface{}{"value": float64(14400)}, body)
+		},
+	})
+	defer server.Close()
+
+	h := httpCloudflareClient{
+		client:      server.Client(),
+		credentials: APITokenCredentials("api-key"),
+		baseUrl:     server.URL,
+	}
+	got, err := h.UpdateZoneSetting(context.Background(), UpdateZoneSettingRequest{ZoneID: "zone-id", SettingID: "browser_cache_ttl", Value: 14400})
+	assert.NoError(t, err)
+	assert.Equal(t, "browser_cache_ttl", got.Setting.ID)
+}
+
+func Test_httpCloudflareClient_PurgeCache(t *testing.T) {
+	tests := []struct {
+		name     string
+		request  PurgeCacheRequest
+		wantBody map[string]interface{}
+	}{
+		{
+			name:     "it should purge everything",
+			request:  PurgeCacheRequest{ZoneID: "zone-id", PurgeEverything: true},
+			wantBody: map[string]interface{}{"purge_everything": true},
+		},
+		{
+			name:     "it should purge files",
+			request:  PurgeCacheRequest{ZoneID: "zone-id", Files: []string{"https://example.com/a.css"}},
+			wantBody: map[string]interface{}{"files": []interface{}{"https://example.com/a.css"}},
+		},
+		{
+			name:     "it should purge tags",
+			request:  PurgeCacheRequest{ZoneID: "zone-id", Tags: []string{"assets"}},
+			wantBody: map[string]interface{}{"tags": []interface{}{"assets"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newMockServer(mockServerConfig{
+				path:       "/client/v4/zones/zone-id/purge_cache",
+				method:     http.MethodPost,
+				statusCode: http.StatusOK,
+				response:   map[string]interface{}{"result": map[string]interface{}{"id": "purge-id"}},
+				assert: func(r *http.Request) {
+					var body map[string]interface{}
+					assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+					assert.Equal(t, tt.wantBody, body)
+				},
+			})
+			defer server.Close()
+
+			h := httpCloudflareClient{
+				client:      server.Client(),
+				credentials: APITokenCredentials("api-key"),
+				baseUrl:     server.URL,
+			}
+			got, err := h.PurgeCache(context.Background(), tt.request)
+			assert.NoError(t, err)
+			assert.Equal(t, &PurgeCacheResponse{Purge: CachePurge{ID: "purge-id"}}, got)
+		})
+	}
+}
@@ -4,7 +4,23 @@ import "context"
 
 type CloudflareClient interface {
 	GetZoneByDomain(context.Context, GetZoneByDomainRequest) (*GetZoneByDomainResponse, error)
+	ListZones(context.Context, ListZonesRequest) (*ListZonesResponse, error)
+	GetZone(context.Context, GetZoneRequest) (*GetZoneResponse, error)
+	CreateZone(context.Context, CreateZoneRequest) (*CreateZoneResponse, error)
+	PatchZone(context.Context, PatchZoneRequest) (*PatchZoneResponse, error)
+	DeleteZone(context.Context, DeleteZoneRequest) error
+	ZoneActivationCheck(context.Context, ZoneActivationCheckRequest) error
+	ListZoneSettings(context.Context, ListZoneSettingsRequest) (*ListZoneSettingsResponse, error)
+	GetZoneSetting(context.Context, GetZoneSettingRequest) (*GetZoneSettingResponse, error)
+	UpdateZoneSetting(context.Context, UpdateZoneSettingRequest) (*UpdateZoneSettingResponse, error)
+	PurgeCache(context.Context, PurgeCacheRequest) (*PurgeCacheResponse, error)
 	GetZoneRecords(context.Context, GetZoneRecordsRequest) (*GetZoneRecordsResponse, error)
+	GetZoneRecord(context.Context, GetZoneRecordRequest) (*GetZoneRecordResponse, error)
 	AddZoneRecord(context.Context, AddZoneRecordRequest) (*AddZoneRecordResponse, error)
+	UpdateZoneRecord(context.Context, UpdateZoneRecordRequest) (*UpdateZoneRecordResponse, error)
+	PatchZoneRecord(context.Context, PatchZoneRecordRequest) (*PatchZoneRecordResponse, error)
 	DeleteZoneRecord(context.Context, DeleteZoneRecordRequest) error
+	VerifyToken(context.Context) (*VerifyTokenResponse, error)
+	GetToken(context.Context, GetTokenRequest) (*GetTokenResponse, error)
+	GetUser(context.Context) (*GetUserResponse, error)
 }
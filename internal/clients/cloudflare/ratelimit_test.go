@@ -0,0 +1,36 @@
+package cloudflare
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter_Wait(t *testing.T) {
+	limiter := NewRateLimiter(100, 2)
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, limiter.Wait(context.Background()))
+		}()
+	}
+	wg.Wait()
+
+	// 2 requests go out in the burst, the other 4 wait 10ms each.
+	assert.GreaterOrEqual(t, time.Since(start), 35*time.Millisecond)
+}
+
+func TestRateLimiter_WaitCancelled(t *testing.T) {
+	limiter := NewRateLimiter(1, 1)
+	assert.NoError(t, limiter.Wait(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, limiter.Wait(ctx), context.DeadlineExceeded)
+}
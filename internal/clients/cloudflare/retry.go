@@ -0,0 +1,135 @@
+package cloudflare
+
+import (
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy retries requests that failed transiently. Every method is retried on 429, since Cloudflare rejected the
+// request without processing it, while network errors and 5xx responses are only retried for idempotent methods,
+// so a record is never created twice.
+type RetryPolicy struct {
+	MaxRetries     int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries:     3,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     30 * time.Second,
+	}
+}
+
+// delay returns how long to wait before retrying the attempt, and false when it must not be retried.
+func (p RetryPolicy) delay(req *http.Request, resp *http.Response, err error, attempt int) (time.Duration, bool) {
+	if attempt >= p.MaxRetries || req.Context().Err() != nil {
+		return 0, false
+	}
+
+	if err != nil {
+		return p.backoff(attempt), idempotent(req.Method)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		if !idempotent(req.Method) {
+			return 0, false
+		}
+	default:
+		return 0, false
+	}
+
+	if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+		return retryAfter, true
+	}
+	return p.backoff(attempt), true
+}
+
+// backoff is exponential with full jitter, so concurrent clients that failed together do not retry together.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	ceiling := p.InitialBackoff
+	for i := 0; i < attempt && ceiling < p.MaxBackoff; i++ {
+		ceiling *= 2
+	}
+	if ceiling > p.MaxBackoff {
+		ceiling = p.MaxBackoff
+	}
+	if ceiling <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(ceiling) + 1))
+}
+
+func idempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// parseRetryAfter reads a Retry-After header given either in seconds or as an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+	return 0, false
+}
+
+// do sends the request through the rate limiter, retrying it according to the retry policy.
+func (h httpCloudflareClient) do(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		if h.limiter != nil {
+			if err := h.limiter.Wait(req.Context()); err != nil {
+				return nil, err
+			}
+		}
+
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+
+		resp, err := h.client.Do(req)
+		delay, retry := h.retryPolicy.delay(req, resp, err, attempt)
+		if !retry {
+			return resp, err
+		}
+
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package cloudflare
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestHttpCloudflareClient_Retry(t *testing.T) {
+	policy := RetryPolicy{MaxRetries: 2, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}
+	tests := []struct {
+		name       string
+		method     string
+		statuses   []int
+		retryAfter string
+		wantCalls  int32
+		wantStatus int
+	}{
+		{
+			name:       "it should retry a GET on a server error",
+			method:     http.MethodGet,
+			statuses:   []int{http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK},
+			wantCalls:  3,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "it should stop after the maximum number of retries",
+			method:     http.MethodDelete,
+			statuses:   []int{http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError, http.StatusOK},
+			wantCalls:  3,
+			wantStatus: http.StatusInternalServerError,
+		},
+		{
+			name:       "it should not retry a POST on a server error",
+			method:     http.MethodPost,
+			statuses:   []int{http.StatusServiceUnavailable, http.StatusOK},
+			wantCalls:  1,
+			wantStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:       "it should retry a POST when rate limited",
+			method:     http.MethodPost,
+			statuses:   []int{http.StatusTooManyRequests, http.StatusOK},
+			retryAfter: "0",
+			wantCalls:  2,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "it should not retry a client error",
+			method:     http.MethodPut,
+			statuses:   []int{http.StatusBadRequest, http.StatusOK},
+			wantCalls:  1,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				call := atomic.AddInt32(&calls, 1)
+				body, _ := io.ReadAll(r.Body)
+				assert.Equal(t, `{"name":"www"}`, string(body))
+				if tt.retryAfter != "" {
+					w.Header().Set("Retry-After", tt.retryAfter)
+				}
+				w.WriteHeader(tt.statuses[call-1])
+			}))
+			defer server.Close()
+
+			h := httpCloudflareClient{client: server.Client(), baseUrl: server.URL, retryPolicy: policy}
+			req, err := h.acquireRequest(context.Background(), tt.method, server.URL, strings.NewReader(`{"name":"www"}`))
+			assert.NoError(t, err)
+
+			resp, err := h.do(req)
+			assert.NoError(t, err)
+			defer resp.Body.Close()
+			assert.Equal(t, tt.wantStatus, resp.StatusCode)
+			assert.Equal(t, tt.wantCalls, atomic.LoadInt32(&calls))
+		})
+	}
+}
+
+func TestHttpCloudflareClient_RetryCancelled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	h := httpCloudflareClient{client: server.Client(), baseUrl: server.URL, retryPolicy: DefaultRetryPolicy()}
+	req, err := h.acquireRequest(ctx, http.MethodGet, server.URL, nil)
+	assert.NoError(t, err)
+
+	_, err = h.do(req)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	delay, ok := parseRetryAfter("3")
+	assert.True(t, ok)
+	assert.Equal(t, 3*time.Second, delay)
+
+	delay, ok = parseRetryAfter(time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat))
+	assert.True(t, ok)
+	assert.Equal(t, time.Duration(0), delay)
+
+	_, ok = parseRetryAfter("")
+	assert.False(t, ok)
+	_, ok = parseRetryAfter("soon")
+	assert.False(t, ok)
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	policy := RetryPolicy{InitialBackoff: 10 * time.Millisecond, MaxBackoff: 40 * time.Millisecond}
+	for attempt := 0; attempt < 10; attempt++ {
+		delay := policy.backoff(attempt)
+		assert.GreaterOrEqual(t, delay, time.Duration(0))
+		assert.LessOrEqual(t, delay, 40*time.Millisecond)
+	}
+	assert.LessOrEqual(t, policy.backoff(0), 10*time.Millisecond)
+}
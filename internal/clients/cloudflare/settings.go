@@ -0,0 +1,96 @@
+package cloudflare
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// zoneSettingSpec lists the values accepted by a zone setting, either strings or integers.
+type zoneSettingSpec struct {
+	values   []string
+	integers []int
+}
+
+var onOff = zoneSettingSpec{values: []string{"on", "off"}}
+
+// zoneSettings are the zone settings that can be changed with a single scalar value, and what each one accepts.
+var zoneSettings = map[string]zoneSettingSpec{
+	"0rtt":                     onOff,
+	"always_online":            onOff,
+	"always_use_https":         onOff,
+	"automatic_https_rewrites": onOff,
+	"brotli":                   onOff,
+	"browser_cache_ttl": {integers: []int{
+		0, 30, 60, 120, 300, 1200, 1800, 3600, 7200, 10800, 14400, 18000, 28800, 43200, 57600, 72000, 86400,
+		172800, 259200, 345600, 432000, 691200, 1382400, 2073600, 2678400, 5356800, 16070400, 31536000,
+	}},
+	"browser_check":            onOff,
+	"cache_level":              {values: []string{"aggressive", "basic", "simplified"}},
+	"challenge_ttl":            {integers: []int{300, 900, 1800, 2700, 3600, 7200, 10800, 14400, 28800, 57600, 86400, 604800, 2592000, 31536000}},
+	"development_mode":         onOff,
+	"early_hints":              onOff,
+	"email_obfuscation":        onOff,
+	"hotlink_protection":       onOff,
+	"http2":                    onOff,
+	"http3":                    onOff,
+	"ip_geolocation":           onOff,
+	"ipv6":                     onOff,
+	"max_upload":               {integers: []int{100, 125, 150, 175, 200, 225, 250, 275, 300, 325, 350, 375, 400, 425, 450, 475, 500}},
+	"min_tls_version":          {values: []string{"1.0", "1.1", "1.2", "1.3"}},
+	"opportunistic_encryption": onOff,
+	"opportunistic_onion":      onOff,
+	"pseudo_ipv4":              {values: []string{"off", "add_header", "overwrite_header"}},
+	"rocket_loader":            onOff,
+	"security_level":           {values: []string{"off", "essentially_off", "low", "medium", "high", "under_attack"}},
+	"server_side_exclude":      onOff,
+	"ssl":                      {values: []string{"off", "flexible", "full", "strict"}},
+	"tls_1_3":                  {values: []string{"on", "off", "zrt"}},
+	"tls_client_auth":          onOff,
+	"websockets":               onOff,
+}
+
+// ZoneSettingIDs returns the settings accepted by ParseZoneSettingValue, sorted.
+func ZoneSettingIDs() []string {
+	ids := make([]string, 0, len(zoneSettings))
+	for id := range zoneSettings {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// ParseZoneSettingValue validates the value of a zone setting and converts it to the type the API expects.
+func ParseZoneSettingValue(id, value string) (interface{}, error) {
+	spec, ok := zoneSettings[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown setting %s, expected one of: %s", ErrInvalidZoneSetting, id, strings.Join(ZoneSettingIDs(), ", "))
+	}
+
+	if spec.integers != nil {
+		integer, err := strconv.Atoi(value)
+		if err != nil || !slices.Contains(spec.integers, integer) {
+			return nil, fmt.Errorf("%w: %s must be one of: %s", ErrInvalidZoneSetting, id, strings.Join(spec.allowed(), ", "))
+		}
+		return integer, nil
+	}
+
+	if !slices.Contains(spec.values, value) {
+		return nil, fmt.Errorf("%w: %s must be one of: %s", ErrInvalidZoneSetting, id, strings.Join(spec.allowed(), ", "))
+	}
+	return value, nil
+}
+
+func (s zoneSettingSpec) allowed() []string {
+	if s.integers == nil {
+		return s.values
+	}
+
+	allowed := make([]string, 0, len(s.integers))
+	for _, integer := range s.integers {
+		allowed = append(allowed, strconv.Itoa(integer))
+	}
+	return allowed
+}
@@ -0,0 +1,43 @@
+package cloudflare
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseZoneSettingValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		value   string
+		want    interface{}
+		wantErr bool
+	}{
+		{name: "it should accept on/off settings", id: "always_use_https", value: "on", want: "on"},
+		{name: "it should accept enumerated values", id: "ssl", value: "strict", want: "strict"},
+		{name: "it should accept version strings", id: "min_tls_version", value: "1.2", want: "1.2"},
+		{name: "it should convert integer settings", id: "browser_cache_ttl", value: "14400", want: 14400},
+		{name: "it should reject values outside of the enumeration", id: "ssl", value: "full_strict", wantErr: true},
+		{name: "it should reject integers that are not allowed", id: "browser_cache_ttl", value: "100", wantErr: true},
+		{name: "it should reject integer settings that are not numbers", id: "max_upload", value: "big", wantErr: true},
+		{name: "it should reject unknown settings", id: "bogus", value: "on", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseZoneSettingValue(tt.id, tt.value)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidZoneSetting)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestZoneSettingIDs(t *testing.T) {
+	ids := ZoneSettingIDs()
+	assert.IsIncreasing(t, ids)
+	assert.Contains(t, ids, "ssl")
+	assert.Contains(t, ids, "development_mode")
+}
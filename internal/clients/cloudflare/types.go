@@ -5,17 +5,33 @@ import (
 	"fmt"
 )
 
+// defaultPerPage is the page size requested from list endpoints, the largest value accepted by the /zones endpoint.
+const defaultPerPage = 50
+
 type ZoneType string
 
 const (
-	ZoneTypeA     ZoneType = "A"
-	ZoneTypeAAAA  ZoneType = "AAAA"
-	ZoneTypeCNAME ZoneType = "CNAME"
-	ZoneTypeTXT   ZoneType = "TXT"
-	ZoneTypeSRV   ZoneType = "SRV"
-	ZoneTypeMX    ZoneType = "MX"
-	ZoneTypeNS    ZoneType = "NS"
-	ZoneTypeSOA   ZoneType = "SOA"
+	ZoneTypeA      ZoneType = "A"
+	ZoneTypeAAAA   ZoneType = "AAAA"
+	ZoneTypeCNAME  ZoneType = "CNAME"
+	ZoneTypeTXT    ZoneType = "TXT"
+	ZoneTypeSRV    ZoneType = "SRV"
+	ZoneTypeMX     ZoneType = "MX"
+	ZoneTypeNS     ZoneType = "NS"
+	ZoneTypeSOA    ZoneType = "SOA"
+	ZoneTypeCAA    ZoneType = "CAA"
+	ZoneTypePTR    ZoneType = "PTR"
+	ZoneTypeHTTPS  ZoneType = "HTTPS"
+	ZoneTypeSVCB   ZoneType = "SVCB"
+	ZoneTypeLOC    ZoneType = "LOC"
+	ZoneTypeNAPTR  ZoneType = "NAPTR"
+	ZoneTypeSSHFP  ZoneType = "SSHFP"
+	ZoneTypeTLSA   ZoneType = "TLSA"
+	ZoneTypeDS     ZoneType = "DS"
+	ZoneTypeDNSKEY ZoneType = "DNSKEY"
+	ZoneTypeCERT   ZoneType = "CERT"
+	ZoneTypeURI    ZoneType = "URI"
+	ZoneTypeSMIMEA ZoneType = "SMIMEA"
 )
 
 var (
@@ -29,6 +45,19 @@ var (
 		ZoneTypeMX,
 		ZoneTypeNS,
 		ZoneTypeSOA,
+		ZoneTypeCAA,
+		ZoneTypePTR,
+		ZoneTypeHTTPS,
+		ZoneTypeSVCB,
+		ZoneTypeLOC,
+		ZoneTypeNAPTR,
+		ZoneTypeSSHFP,
+		ZoneTypeTLSA,
+		ZoneTypeDS,
+		ZoneTypeDNSKEY,
+		ZoneTypeCERT,
+		ZoneTypeURI,
+		ZoneTypeSMIMEA,
 	}
 )
 
@@ -40,3 +69,21 @@ func ParseZoneType(s string) (ZoneType, error) {
 	}
 	return "", fmt.Errorf("%w: %s", InvalidZoneType, s)
 }
+
+// ZoneSetup is how a zone is served: full makes Cloudflare its authoritative DNS, partial keeps the DNS elsewhere
+// and points CNAME records at Cloudflare.
+type ZoneSetup string
+
+const (
+	ZoneSetupFull    ZoneSetup = "full"
+	ZoneSetupPartial ZoneSetup = "partial"
+)
+
+func ParseZoneSetup(s string) (ZoneSetup, error) {
+	switch setup := ZoneSetup(s); setup {
+	case ZoneSetupFull, ZoneSetupPartial:
+		return setup, nil
+	default:
+		return "", fmt.Errorf("%w: %s, expected %s or %s", ErrInvalidZoneSetup, s, ZoneSetupFull, ZoneSetupPartial)
+	}
+}
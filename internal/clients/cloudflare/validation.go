@@ -0,0 +1,218 @@
+package cloudflare
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+const (
+	// maxTXTContentLength is the longest TXT content accepted by Cloudflare.
+	maxTXTContentLength = 2048
+	maxTXTChunkLength   = 255
+	maxHostnameLength   = 253
+	maxLabelLength      = 63
+	maxPriority         = 65535
+)
+
+var proxiableZoneTypes = map[ZoneType]bool{
+	ZoneTypeA:     true,
+	ZoneTypeAAAA:  true,
+	ZoneTypeCNAME: true,
+}
+
+// IsProxiable reports whether records of the type can be proxied through Cloudflare.
+func (z ZoneType) IsProxiable() bool {
+	return proxiableZoneTypes[z]
+}
+
+// ValidateZoneRecord checks a record before it is sent to the API so mistakes fail with a precise message instead of an opaque 400.
+// existing holds the records already in the zone and is used for CNAME coexistence checks; a record with the same ID as the
+// validated one is ignored so updates do not conflict with themselves.
+func ValidateZoneRecord(zone string, record ZoneRecordRequest, existing []ZoneRecord) error {
+	zone = strings.ToLower(strings.TrimSuffix(zone, "."))
+	name := strings.ToLower(strings.TrimSuffix(record.Name, "."))
+
+	if _, err := ParseZoneType(string(record.Type)); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidRecord, err.Error())
+	}
+	if err := validateHostname(name, true); err != nil {
+		return fmt.Errorf("%w: name %q %s", ErrInvalidRecord, record.Name, err.Error())
+	}
+	if name != zone && !strings.HasSuffix(name, "."+zone) {
+		return fmt.Errorf("%w: name %q is outside of zone %s", ErrInvalidRecord, record.Name, zone)
+	}
+	if record.TTL < 1 || record.TTL > 86400 {
+		return fmt.Errorf("%w: TTL must be between 1 and 86400, got %d", ErrInvalidRecord, record.TTL)
+	}
+	if record.Proxied && !record.Type.IsProxiable() {
+		return fmt.Errorf("%w: %s records cannot be proxied, only A, AAAA and CNAME records can", ErrInvalidRecord, record.Type)
+	}
+
+	if err := validatePriority(record); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidRecord, err.Error())
+	}
+
+	if record.Type.RequiresData() {
+		if len(record.Data) == 0 {
+			return fmt.Errorf("%w: %s records require data fields: %s", ErrInvalidRecord, record.Type, strings.Join(record.Type.DataFields(), ", "))
+		}
+	} else {
+		if len(record.Data) > 0 {
+			return fmt.Errorf("%w: %s records take content, not data", ErrInvalidRecord, record.Type)
+		}
+		if err := validateContent(record); err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidRecord, err.Error())
+		}
+	}
+
+	if record.Type == ZoneTypeCNAME && name == zone {
+		return fmt.Errorf("%w: CNAME records cannot be created at the zone apex %s", ErrInvalidRecord, zone)
+	}
+
+	return validateCoexistence(name, record, existing)
+}
+
+func validatePriority(record ZoneRecordRequest) error {
+	switch record.Type {
+	case ZoneTypeMX, ZoneTypeURI:
+		if record.Priority == nil {
+			return fmt.Errorf("%s records require a priority", record.Type)
+		}
+	case ZoneTypeSRV:
+	default:
+		if record.Priority != nil {
+			return fmt.Errorf("priority is only used by MX, SRV and URI records, not %s", record.Type)
+		}
+		return nil
+	}
+
+	if record.Priority != nil && (*record.Priority < 0 || *record.Priority > maxPriority) {
+		return fmt.Errorf("priority must be between 0 and %d, got %d", maxPriority, *record.Priority)
+	}
+	return nil
+}
+
+func validateContent(record ZoneRecordRequest) error {
+	content := record.Content
+	if content == "" {
+		return fmt.Errorf("%s record content is empty", record.Type)
+	}
+
+	switch record.Type {
+	case ZoneTypeA:
+		ip := net.ParseIP(content)
+		if ip == nil || ip.To4() == nil || strings.Contains(content, ":") {
+			if ip != nil {
+				return fmt.Errorf("A record content %q is an IPv6 address, use an AAAA record instead", content)
+			}
+			return fmt.Errorf("A record content %q is not a valid IPv4 address", content)
+		}
+	case ZoneTypeAAAA:
+		ip := net.ParseIP(content)
+		if ip == nil || !strings.Contains(content, ":") {
+			if ip != nil {
+				return fmt.Errorf("AAAA record content %q is an IPv4 address, use an A record instead", content)
+			}
+			return fmt.Errorf("AAAA record content %q is not a valid IPv6 address", content)
+		}
+	case ZoneTypeCNAME, ZoneTypeNS, ZoneTypeMX, ZoneTypePTR:
+		if net.ParseIP(content) != nil {
+			return fmt.Errorf("%s record content %q must be a hostname, not an IP address", record.Type, content)
+		}
+		if err := validateHostname(strings.ToLower(strings.TrimSuffix(content, ".")), false); err != nil {
+			return fmt.Errorf("%s record content %q %s", record.Type, content, err.Error())
+		}
+	case ZoneTypeTXT:
+		if len(content) > maxTXTContentLength {
+			return fmt.Errorf("TXT record content is %d characters long, the maximum is %d", len(content), maxTXTContentLength)
+		}
+		for i, chunk := range quotedChunks(content) {
+			if len(chunk) > maxTXTChunkLength {
+				return fmt.Errorf("TXT record string %d is %d characters long, quoted strings are limited to %d", i+1, len(chunk), maxTXTChunkLength)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateCoexistence enforces that a CNAME is the only record at its name.
+func validateCoexistence(name string, record ZoneRecordRequest, existing []ZoneRecord) error {
+	for _, other := range existing {
+		if record.ID != "" && other.ID == record.ID {
+			continue
+		}
+		if strings.ToLower(strings.TrimSuffix(other.Name, ".")) != name {
+			continue
+		}
+
+		if record.Type == ZoneTypeCNAME {
+			return fmt.Errorf("%w: a CNAME record cannot coexist with the existing %s record at %s", ErrInvalidRecord, other.Type, name)
+		}
+		if other.Type == ZoneTypeCNAME {
+			return fmt.Errorf("%w: a %s record cannot be added at %s because a CNAME record already exists there", ErrInvalidRecord, record.Type, name)
+		}
+	}
+	return nil
+}
+
+// validateHostname checks RFC 1035 name syntax, also allowing underscores used by service labels such as _dmarc.
+func validateHostname(name string, allowWildcard bool) error {
+	if name == "" {
+		return fmt.Errorf("is empty")
+	}
+	if len(name) > maxHostnameLength {
+		return fmt.Errorf("is longer than %d characters", maxHostnameLength)
+	}
+
+	labels := strings.Split(name, ".")
+	for i, label := range labels {
+		if label == "*" && i == 0 && allowWildcard {
+			continue
+		}
+		if label == "" {
+			return fmt.Errorf("has an empty label")
+		}
+		if len(label) > maxLabelLength {
+			return fmt.Errorf("has a label longer than %d characters: %s", maxLabelLength, label)
+		}
+		if strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+			return fmt.Errorf("has a label starting or ending with a hyphen: %s", label)
+		}
+		for _, c := range label {
+			if !(c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '-' || c == '_') {
+				return fmt.Errorf("contains the invalid character %q", c)
+			}
+		}
+	}
+	return nil
+}
+
+// quotedChunks returns the character-strings of TXT content in presentation form, or nil for plain content.
+func quotedChunks(content string) []string {
+	if !strings.HasPrefix(content, `"`) || !strings.HasSuffix(content, `"`) {
+		return nil
+	}
+
+	var chunks []string
+	var current strings.Builder
+	inQuotes := false
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+		switch {
+		case c == '\\' && inQuotes && i+1 < len(content):
+			current.WriteByte(content[i+1])
+			i++
+		case c == '"':
+			if inQuotes {
+				chunks = append(chunks, current.String())
+				current.Reset()
+			}
+			inQuotes = !inQuotes
+		case inQuotes:
+			current.WriteByte(c)
+		}
+	}
+	return chunks
+}
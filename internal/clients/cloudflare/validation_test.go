@@ -0,0 +1,118 @@
+package cloudflare
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestValidateZoneRecord(t *testing.T) {
+	existing := []ZoneRecord{
+		{ID: "cname", Name: "www.example.com", Type: ZoneTypeCNAME, Content: "example.com"},
+		{ID: "a", Name: "api.example.com", Type: ZoneTypeA, Content: "192.0.2.1"},
+	}
+	tests := []struct {
+		name    string
+		record  ZoneRecordRequest
+		wantErr string
+	}{
+		{
+			name:   "it should accept a valid A record",
+			record: ZoneRecordRequest{Type: ZoneTypeA, Name: "app.example.com", Content: "192.0.2.1", TTL: 1, Proxied: true},
+		},
+		{
+			name:    "it should reject an IPv6 address on an A record",
+			record:  ZoneRecordRequest{Type: ZoneTypeA, Name: "app.example.com", Content: "2001:db8::1", TTL: 1},
+			wantErr: "use an AAAA record instead",
+		},
+		{
+			name:    "it should reject an IPv4 address on an AAAA record",
+			record:  ZoneRecordRequest{Type: ZoneTypeAAAA, Name: "app.example.com", Content: "192.0.2.1", TTL: 1},
+			wantErr: "use an A record instead",
+		},
+		{
+			name:    "it should reject a CNAME pointing at an IP",
+			record:  ZoneRecordRequest{Type: ZoneTypeCNAME, Name: "app.example.com", Content: "192.0.2.1", TTL: 1},
+			wantErr: "must be a hostname, not an IP address",
+		},
+		{
+			name:    "it should reject a CNAME at the apex",
+			record:  ZoneRecordRequest{Type: ZoneTypeCNAME, Name: "example.com", Content: "other.example.net", TTL: 1},
+			wantErr: "zone apex",
+		},
+		{
+			name:    "it should reject a CNAME next to another record",
+			record:  ZoneRecordRequest{Type: ZoneTypeCNAME, Name: "api.example.com", Content: "other.example.net", TTL: 1},
+			wantErr: "cannot coexist with the existing A record",
+		},
+		{
+			name:    "it should reject a record next to a CNAME",
+			record:  ZoneRecordRequest{Type: ZoneTypeTXT, Name: "www.example.com", Content: "hello", TTL: 1},
+			wantErr: "a CNAME record already exists there",
+		},
+		{
+			name:   "it should not conflict with the record being updated",
+			record: ZoneRecordRequest{ID: "cname", Type: ZoneTypeCNAME, Name: "www.example.com", Content: "example.net", TTL: 1},
+		},
+		{
+			name:    "it should reject proxied records of non proxiable types",
+			record:  ZoneRecordRequest{Type: ZoneTypeTXT, Name: "app.example.com", Content: "hello", TTL: 1, Proxied: true},
+			wantErr: "cannot be proxied",
+		},
+		{
+			name:    "it should reject TXT strings longer than 255 characters",
+			record:  ZoneRecordRequest{Type: ZoneTypeTXT, Name: "app.example.com", Content: `"` + strings.Repeat("a", 256) + `"`, TTL: 1},
+			wantErr: "quoted strings are limited to 255",
+		},
+		{
+			name:    "it should reject TXT content longer than 2048 characters",
+			record:  ZoneRecordRequest{Type: ZoneTypeTXT, Name: "app.example.com", Content: strings.Repeat("a", 2049), TTL: 1},
+			wantErr: "the maximum is 2048",
+		},
+		{
+			name:    "it should reject invalid names",
+			record:  ZoneRecordRequest{Type: ZoneTypeA, Name: "bad name.example.com", Content: "192.0.2.1", TTL: 1},
+			wantErr: "invalid character",
+		},
+		{
+			name:    "it should reject names outside of the zone",
+			record:  ZoneRecordRequest{Type: ZoneTypeA, Name: "app.example.net", Content: "192.0.2.1", TTL: 1},
+			wantErr: "outside of zone",
+		},
+		{
+			name:   "it should accept wildcard and service names",
+			record: ZoneRecordRequest{Type: ZoneTypeTXT, Name: "*._domainkey.example.com", Content: "v=DKIM1", TTL: 1},
+		},
+		{
+			name:    "it should require a priority for MX records",
+			record:  ZoneRecordRequest{Type: ZoneTypeMX, Name: "example.com", Content: "mail.example.com", TTL: 1},
+			wantErr: "MX records require a priority",
+		},
+		{
+			name:    "it should reject a priority on other types",
+			record:  ZoneRecordRequest{Type: ZoneTypeA, Name: "app.example.com", Content: "192.0.2.1", TTL: 1, Priority: func() *int { p := 10; return &p }()},
+			wantErr: "priority is only used by MX, SRV and URI records",
+		},
+		{
+			name:    "it should reject out of range priorities",
+			record:  ZoneRecordRequest{Type: ZoneTypeMX, Name: "example.com", Content: "mail.example.com", TTL: 1, Priority: func() *int { p := 70000; return &p }()},
+			wantErr: "priority must be between 0 and 65535",
+		},
+		{
+			name:    "it should require data for structured types",
+			record:  ZoneRecordRequest{Type: ZoneTypeCAA, Name: "example.com", Content: "0 issue ca.example", TTL: 1},
+			wantErr: "require data fields",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateZoneRecord("example.com", tt.record, existing)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, ErrInvalidRecord)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
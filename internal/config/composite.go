@@ -2,31 +2,60 @@ package config
 
 //go:generate mockgen -destination mocks/mock_composite.go . Composite
 type Composite interface {
+	// AuthMethod is token, global_key or origin_ca_key. When empty it is inferred from the settings present.
+	AuthMethod() string
 	CloudflareAPIKey() string
+	CloudflareEmail() string
+	CloudflareGlobalAPIKey() string
+	CloudflareOriginCAKey() string
 }
 
 type composite struct {
 	entries []Composite
 }
 
-func NewComposite() (Composite, error) {
+// NewComposite resolves settings from the active profile of the local config, then from the environment.
+// profile is the value of the --profile flag, empty when it was not passed.
+func NewComposite(profile string) (Composite, error) {
 	localConfig, err := LoadLocalConfig()
 	if err != nil {
 		return nil, err
 	}
 
+	activeProfile, _ := localConfig.Profile(localConfig.ActiveProfile(profile))
 	return &composite{
 		entries: []Composite{
-			localConfig,
+			activeProfile,
 			envConfig{},
 		},
 	}, nil
 }
 
+func (c *composite) AuthMethod() string {
+	return c.first(Composite.AuthMethod)
+}
+
 func (c *composite) CloudflareAPIKey() string {
+	return c.first(Composite.CloudflareAPIKey)
+}
+
+func (c *composite) CloudflareEmail() string {
+	return c.first(Composite.CloudflareEmail)
+}
+
+func (c *composite) CloudflareGlobalAPIKey() string {
+	return c.first(Composite.CloudflareGlobalAPIKey)
+}
+
+func (c *composite) CloudflareOriginCAKey() string {
+	return c.first(Composite.CloudflareOriginCAKey)
+}
+
+// first returns the first non-empty value of a setting across the entries.
+func (c *composite) first(setting func(Composite) string) string {
 	for _, entry := range c.entries {
-		if key := entry.CloudflareAPIKey(); key != "" {
-			return key
+		if value := setting(entry); value != "" {
+			return value
 		}
 	}
 
@@ -8,9 +8,22 @@ import (
 )
 
 func TestNewComposite(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv(profileEnv, "")
+	err := SaveLocalConfig(LocalConfig{
+		CurrentProfile: "staging",
+		Profiles: map[string]Profile{
+			DefaultProfile: {CloudflareAPIKeyEntry: "default-key"},
+			"staging":      {CloudflareAPIKeyEntry: "staging-key"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("SaveLocalConfig() error = %v", err)
+	}
 
 	tests := []struct {
 		name    string
+		profile string
 		want    Composite
 		wantErr bool
 	}{
@@ -18,13 +31,29 @@ func TestNewComposite(t *testing.T) {
 			name: "success",
 			want: &composite{
 				entries: []Composite{
-					func() Composite {
-						c, err := LoadLocalConfig()
-						if err != nil {
-							t.Errorf("NewComposite() error = %v, wantErr %v", err, false)
-						}
-						return c
-					}(),
+					Profile{CloudflareAPIKeyEntry: "staging-key"},
+					envConfig{},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "should use the profile passed by flag",
+			profile: DefaultProfile,
+			want: &composite{
+				entries: []Composite{
+					Profile{CloudflareAPIKeyEntry: "default-key"},
+					envConfig{},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "should fall back to an empty profile when it does not exist",
+			profile: "missing",
+			want: &composite{
+				entries: []Composite{
+					Profile{},
 					envConfig{},
 				},
 			},
@@ -33,7 +62,7 @@ func TestNewComposite(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := NewComposite()
+			got, err := NewComposite(tt.profile)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("NewComposite() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -125,3 +154,38 @@ func Test_composite_CloudflareAPIKey(t *testing.T) {
 		})
 	}
 }
+
+func Test_composite_AuthSettings(t *testing.T) {
+	t.Setenv("CLOUDFLARE_AUTH_METHOD", "")
+	t.Setenv("CLOUDFLARE_EMAIL", "env@example.com")
+	t.Setenv("CLOUDFLARE_GLOBAL_API_KEY", "env-global-key")
+	t.Setenv("CLOUDFLARE_ORIGIN_CA_KEY", "env-origin-ca-key")
+
+	c := &composite{
+		entries: []Composite{
+			Profile{
+				AuthMethodEntry:             "global_key",
+				CloudflareGlobalAPIKeyEntry: "profile-global-key",
+			},
+			envConfig{},
+		},
+	}
+
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{name: "should read the auth method from the profile", got: c.AuthMethod(), want: "global_key"},
+		{name: "should prefer the profile over the environment", got: c.CloudflareGlobalAPIKey(), want: "profile-global-key"},
+		{name: "should fall back to the environment for the email", got: c.CloudflareEmail(), want: "env@example.com"},
+		{name: "should fall back to the environment for the origin CA key", got: c.CloudflareOriginCAKey(), want: "env-origin-ca-key"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got = %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
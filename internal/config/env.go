@@ -4,6 +4,22 @@ import "os"
 
 type envConfig struct{}
 
+func (c envConfig) AuthMethod() string {
+	return os.Getenv("CLOUDFLARE_AUTH_METHOD")
+}
+
 func (c envConfig) CloudflareAPIKey() string {
 	return os.Getenv("CLOUDFLARE_API_KEY")
 }
+
+func (c envConfig) CloudflareEmail() string {
+	return os.Getenv("CLOUDFLARE_EMAIL")
+}
+
+func (c envConfig) CloudflareGlobalAPIKey() string {
+	return os.Getenv("CLOUDFLARE_GLOBAL_API_KEY")
+}
+
+func (c envConfig) CloudflareOriginCAKey() string {
+	return os.Getenv("CLOUDFLARE_ORIGIN_CA_KEY")
+}
@@ -7,14 +7,18 @@ import (
 )
 
 type LocalConfig struct {
-	CloudflareAPIKeyEntry string `json:"cloudflare_api_key"`
+	CurrentProfile string             `json:"current_profile,omitempty"`
+	Profiles       map[string]Profile `json:"profiles,omitempty"`
 }
 
-func (c LocalConfig) CloudflareAPIKey() string {
-	return c.CloudflareAPIKeyEntry
+// legacyLocalConfig is the layout written before profiles existed, a single key at the top level.
+type legacyLocalConfig struct {
+	LocalConfig
+	CloudflareAPIKeyEntry string `json:"cloudflare_api_key,omitempty"`
 }
 
-func acquireLocalConfigPath() string {
+// AcquireLocalConfigPath returns the directory holding the configuration and the caches of the CLI.
+func AcquireLocalConfigPath() string {
 	return fmt.Sprintf("%s/.config/cloudflare-cli", os.Getenv("HOME"))
 }
 
@@ -29,7 +33,7 @@ func createPathIfNotExists(path string) error {
 }
 
 func SaveLocalConfig(config LocalConfig) error {
-	path := acquireLocalConfigPath()
+	path := AcquireLocalConfigPath()
 	if err := createPathIfNotExists(path); err != nil {
 		return err
 	}
@@ -53,7 +57,7 @@ func SaveLocalConfig(config LocalConfig) error {
 }
 
 func LoadLocalConfig() (LocalConfig, error) {
-	path := acquireLocalConfigPath()
+	path := AcquireLocalConfigPath()
 	file, err := os.Open(fmt.Sprintf("%s/config.json", path))
 	if err != nil {
 		if os.IsNotExist(err) {
@@ -63,10 +67,17 @@ func LoadLocalConfig() (LocalConfig, error) {
 	}
 	defer file.Close()
 
-	var config LocalConfig
+	var config legacyLocalConfig
 	if err := json.NewDecoder(file).Decode(&config); err != nil {
 		return LocalConfig{}, err
 	}
 
-	return config, nil
+	// A key saved before profiles existed becomes the default profile, it is dropped from the top level on the next save.
+	if config.CloudflareAPIKeyEntry != "" {
+		if _, ok := config.Profiles[DefaultProfile]; !ok {
+			config.SetProfile(DefaultProfile, Profile{CloudflareAPIKeyEntry: config.CloudflareAPIKeyEntry})
+		}
+	}
+
+	return config.LocalConfig, nil
 }
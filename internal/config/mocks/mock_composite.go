@@ -38,6 +38,20 @@ func (m *MockComposite) EXPECT() *MockCompositeMockRecorder {
 	return m.recorder
 }
 
+// AuthMethod mocks base method.
+func (m *MockComposite) AuthMethod() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthMethod")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// AuthMethod indicates an expected call of AuthMethod.
+func (mr *MockCompositeMockRecorder) AuthMethod() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthMethod", reflect.TypeOf((*MockComposite)(nil).AuthMethod))
+}
+
 // CloudflareAPIKey mocks base method.
 func (m *MockComposite) CloudflareAPIKey() string {
 	m.ctrl.T.Helper()
@@ -51,3 +65,45 @@ func (mr *MockCompositeMockRecorder) CloudflareAPIKey() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloudflareAPIKey", reflect.TypeOf((*MockComposite)(nil).CloudflareAPIKey))
 }
+
+// CloudflareEmail mocks base method.
+func (m *MockComposite) CloudflareEmail() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloudflareEmail")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// CloudflareEmail indicates an expected call of CloudflareEmail.
+func (mr *MockCompositeMockRecorder) CloudflareEmail() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloudflareEmail", reflect.TypeOf((*MockComposite)(nil).CloudflareEmail))
+}
+
+// CloudflareGlobalAPIKey mocks base method.
+func (m *MockComposite) CloudflareGlobalAPIKey() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloudflareGlobalAPIKey")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// CloudflareGlobalAPIKey indicates an expected call of CloudflareGlobalAPIKey.
+func (mr *MockCompositeMockRecorder) CloudflareGlobalAPIKey() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloudflareGlobalAPIKey", reflect.TypeOf((*MockComposite)(nil).CloudflareGlobalAPIKey))
+}
+
+// CloudflareOriginCAKey mocks base method.
+func (m *MockComposite) CloudflareOriginCAKey() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloudflareOriginCAKey")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// CloudflareOriginCAKey indicates an expected call of CloudflareOriginCAKey.
+func (mr *MockCompositeMockRecorder) CloudflareOriginCAKey() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloudflareOriginCAKey", reflect.TypeOf((*MockComposite)(nil).CloudflareOriginCAKey))
+}
@@ -0,0 +1,106 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+)
+
+const (
+	DefaultProfile = "default"
+	profileEnv     = "CLOUDFLARE_PROFILE"
+)
+
+var ErrProfileNotFound = errors.New("profile not found")
+
+// Profile holds the settings of one Cloudflare account.
+type Profile struct {
+	AuthMethodEntry             string `json:"auth_method,omitempty"`
+	CloudflareAPIKeyEntry       string `json:"cloudflare_api_key,omitempty"`
+	CloudflareEmailEntry        string `json:"cloudflare_email,omitempty"`
+	CloudflareGlobalAPIKeyEntry string `json:"cloudflare_global_api_key,omitempty"`
+	CloudflareOriginCAKeyEntry  string `json:"cloudflare_origin_ca_key,omitempty"`
+}
+
+func (p Profile) AuthMethod() string {
+	return p.AuthMethodEntry
+}
+
+func (p Profile) CloudflareAPIKey() string {
+	return p.CloudflareAPIKeyEntry
+}
+
+func (p Profile) CloudflareEmail() string {
+	return p.CloudflareEmailEntry
+}
+
+func (p Profile) CloudflareGlobalAPIKey() string {
+	return p.CloudflareGlobalAPIKeyEntry
+}
+
+func (p Profile) CloudflareOriginCAKey() string {
+	return p.CloudflareOriginCAKeyEntry
+}
+
+// ProfileFromEnv returns the profile selected by the CLOUDFLARE_PROFILE environment variable.
+func ProfileFromEnv() string {
+	return os.Getenv(profileEnv)
+}
+
+// ActiveProfile resolves the profile to use: the --profile flag, then CLOUDFLARE_PROFILE,
+// then the profile chosen with "config profiles use", then the default profile.
+func (c LocalConfig) ActiveProfile(flag string) string {
+	if flag != "" {
+		return flag
+	}
+	if env := ProfileFromEnv(); env != "" {
+		return env
+	}
+	if c.CurrentProfile != "" {
+		return c.CurrentProfile
+	}
+	return DefaultProfile
+}
+
+func (c LocalConfig) Profile(name string) (Profile, bool) {
+	profile, ok := c.Profiles[name]
+	return profile, ok
+}
+
+// ProfileNames returns the name of every profile, sorted.
+func (c LocalConfig) ProfileNames() []string {
+	names := make([]string, 0, len(c.Profiles))
+	for name := range c.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (c *LocalConfig) SetProfile(name string, profile Profile) {
+	if c.Profiles == nil {
+		c.Profiles = map[string]Profile{}
+	}
+	c.Profiles[name] = profile
+}
+
+// UseProfile makes name the profile used when neither --profile nor CLOUDFLARE_PROFILE is set.
+func (c *LocalConfig) UseProfile(name string) error {
+	if _, ok := c.Profiles[name]; !ok {
+		return fmt.Errorf("%w: %s", ErrProfileNotFound, name)
+	}
+	c.CurrentProfile = name
+	return nil
+}
+
+func (c *LocalConfig) DeleteProfile(name string) error {
+	if _, ok := c.Profiles[name]; !ok {
+		return fmt.Errorf("%w: %s", ErrProfileNotFound, name)
+	}
+	delete(c.Profiles, name)
+	if c.CurrentProfile == name {
+		c.CurrentProfile = ""
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package config
+
+import (
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLocalConfig_ActiveProfile(t *testing.T) {
+	tests := []struct {
+		name   string
+		config LocalConfig
+		flag   string
+		env    string
+		want   string
+	}{
+		{
+			name: "it should default to the default profile",
+			want: DefaultProfile,
+		},
+		{
+			name:   "it should use the current profile",
+			config: LocalConfig{CurrentProfile: "staging"},
+			want:   "staging",
+		},
+		{
+			name:   "it should prefer the environment over the current profile",
+			config: LocalConfig{CurrentProfile: "staging"},
+			env:    "prod",
+			want:   "prod",
+		},
+		{
+			name:   "it should prefer the flag over everything else",
+			config: LocalConfig{CurrentProfile: "staging"},
+			flag:   "client",
+			env:    "prod",
+			want:   "client",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(profileEnv, tt.env)
+			assert.Equal(t, tt.want, tt.config.ActiveProfile(tt.flag))
+		})
+	}
+}
+
+func TestLocalConfig_Profiles(t *testing.T) {
+	var config LocalConfig
+	config.SetProfile("staging", Profile{CloudflareAPIKeyEntry: "staging-key"})
+	config.SetProfile("prod", Profile{CloudflareAPIKeyEntry: "prod-key"})
+	assert.Equal(t, []string{"prod", "staging"}, config.ProfileNames())
+
+	assert.ErrorIs(t, config.UseProfile("missing"), ErrProfileNotFound)
+	assert.NoError(t, config.UseProfile("prod"))
+	assert.Equal(t, "prod", config.CurrentProfile)
+
+	assert.NoError(t, config.DeleteProfile("prod"))
+	assert.Empty(t, config.CurrentProfile)
+	assert.ErrorIs(t, config.DeleteProfile("prod"), ErrProfileNotFound)
+	assert.Equal(t, []string{"staging"}, config.ProfileNames())
+}
+
+func TestLoadLocalConfig_LegacyKey(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	path := filepath.Join(home, ".config", "cloudflare-cli")
+	assert.NoError(t, os.MkdirAll(path, 0700))
+	assert.NoError(t, os.WriteFile(filepath.Join(path, "config.json"), []byte(`{"cloudflare_api_key":"legacy-key"}`), 0600))
+
+	config, err := LoadLocalConfig()
+	assert.NoError(t, err)
+	profile, ok := config.Profile(DefaultProfile)
+	assert.True(t, ok)
+	assert.Equal(t, "legacy-key", profile.CloudflareAPIKey())
+
+	assert.NoError(t, SaveLocalConfig(config))
+	data, err := os.ReadFile(filepath.Join(path, "config.json"))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"profiles":{"default":{"cloudflare_api_key":"legacy-key"}}}`, string(data))
+}
@@ -3,13 +3,61 @@ package constants
 const (
 	CloudflareAPIBaseURL = "https://api.cloudflare.com"
 
-	FlagDomain  = "domain"
-	FlagName    = "name"
-	FlagType    = "type"
-	FlagContent = "content"
-	FlagTTL     = "ttl"
-	FlagProxied = "proxied"
-	FlagTags    = "tags"
-	FlagComment = "comment"
-	FlagID      = "id"
+	FlagDomain   = "domain"
+	FlagName     = "name"
+	FlagType     = "type"
+	FlagContent  = "content"
+	FlagData     = "data"
+	FlagPriority = "priority"
+	FlagTTL      = "ttl"
+	FlagProxied  = "proxied"
+	FlagTags     = "tags"
+	FlagComment  = "comment"
+	FlagID       = "id"
+	FlagNewName  = "new-name"
+	FlagFile     = "file"
+	FlagPurge    = "purge"
+	FlagExitCode = "exit-code"
+
+	FlagDefaultTTL   = "default-ttl"
+	FlagOrigin       = "origin"
+	FlagSkipExisting = "skip-existing"
+
+	FlagIPv4           = "ipv4"
+	FlagIPv6           = "ipv6"
+	FlagIPv4Source     = "ipv4-source"
+	FlagIPv6Source     = "ipv6-source"
+	FlagInterval       = "interval"
+	FlagInitialBackoff = "initial-backoff"
+	FlagMaxBackoff     = "max-backoff"
+	FlagOnce           = "once"
+
+	FlagStatus      = "status"
+	FlagAccountID   = "account-id"
+	FlagAccountName = "account-name"
+	FlagYes         = "yes"
+
+	FlagAll         = "all"
+	FlagConcurrency = "concurrency"
+
+	FlagEverything = "everything"
+	FlagURLs       = "urls"
+	FlagHosts      = "hosts"
+	FlagPrefixes   = "prefixes"
+
+	FlagOutput   = "output"
+	FlagTemplate = "template"
+	FlagProfile  = "profile"
+
+	FlagRetries      = "retries"
+	FlagRateLimit    = "rate-limit"
+	FlagZoneCacheTTL = "zone-cache-ttl"
+	FlagZoneID       = "zone-id"
+	FlagVerbose      = "verbose"
+	FlagTraceFile    = "trace-file"
+	FlagTraceBodies  = "trace-bodies"
+	FlagDryRun       = "dry-run"
+
+	// AnnotationProfileOptional marks command trees that work on profiles that do not exist yet, eg. "config set".
+	AnnotationProfileOptional = "profile-optional"
 )
@@ -0,0 +1,149 @@
+package ddns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+type Family string
+
+const (
+	FamilyIPv4 Family = "ipv4"
+	FamilyIPv6 Family = "ipv6"
+
+	// maxResponseSize bounds what is read from an echo endpoint or command, an address is a few dozen bytes.
+	maxResponseSize = 1024
+	httpTimeout     = 10 * time.Second
+)
+
+var (
+	ErrInvalidSource = errors.New("invalid address source")
+	ErrNoAddress     = errors.New("no address detected")
+)
+
+// Source detects the current public address of the machine for an address family.
+type Source interface {
+	Detect(ctx context.Context, family Family) (net.IP, error)
+}
+
+// HTTPSource reads the address from an echo endpoint that answers with the caller's IP as plain text, eg. https://api.ipify.org.
+type HTTPSource struct {
+	Client *http.Client
+	URL    string
+}
+
+func (s HTTPSource) Detect(ctx context.Context, family Family) (net.IP, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrNoAddress, err.Error())
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: %s answered with status code %d", ErrNoAddress, s.URL, resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrNoAddress, err.Error())
+	}
+
+	return parseAddress(string(body), family)
+}
+
+// InterfaceSource reads the first global unicast address of a local network interface.
+type InterfaceSource struct {
+	Name string
+}
+
+func (s InterfaceSource) Detect(_ context.Context, family Family) (net.IP, error) {
+	iface, err := net.InterfaceByName(s.Name)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrNoAddress, err.Error())
+	}
+
+	addrs, err := iface.Addrs()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrNoAddress, err.Error())
+	}
+
+	for _, addr := range addrs {
+		ipNet, ok := addr.(*net.IPNet)
+		if !ok || !ipNet.IP.IsGlobalUnicast() || !matchesFamily(ipNet.IP, family) {
+			continue
+		}
+		return ipNet.IP, nil
+	}
+
+	return nil, fmt.Errorf("%w: interface %s has no global %s address", ErrNoAddress, s.Name, family)
+}
+
+// CommandSource runs a shell command that prints the address on stdout.
+type CommandSource struct {
+	Command string
+}
+
+func (s CommandSource) Detect(ctx context.Context, family Family) (net.IP, error) {
+	out, err := exec.CommandContext(ctx, "sh", "-c", s.Command).Output()
+	if err != nil {
+		return nil, fmt.Errorf("%w: command %q failed: %s", ErrNoAddress, s.Command, err.Error())
+	}
+	if len(out) > maxResponseSize {
+		out = out[:maxResponseSize]
+	}
+
+	return parseAddress(string(out), family)
+}
+
+// ParseSource builds a source from its flag form: an http(s) URL, "interface:<name>" or "command:<shell command>".
+func ParseSource(spec string) (Source, error) {
+	switch {
+	case strings.HasPrefix(spec, "http://") || strings.HasPrefix(spec, "https://"):
+		return HTTPSource{Client: &http.Client{Timeout: httpTimeout}, URL: spec}, nil
+	case strings.HasPrefix(spec, "interface:"):
+		name := strings.TrimPrefix(spec, "interface:")
+		if name == "" {
+			return nil, fmt.Errorf("%w: missing interface name in %q", ErrInvalidSource, spec)
+		}
+		return InterfaceSource{Name: name}, nil
+	case strings.HasPrefix(spec, "command:"):
+		command := strings.TrimPrefix(spec, "command:")
+		if command == "" {
+			return nil, fmt.Errorf("%w: missing command in %q", ErrInvalidSource, spec)
+		}
+		return CommandSource{Command: command}, nil
+	default:
+		return nil, fmt.Errorf("%w: %q, expected an http(s) URL, interface:<name> or command:<command>", ErrInvalidSource, spec)
+	}
+}
+
+func parseAddress(s string, family Family) (net.IP, error) {
+	value := strings.TrimSpace(s)
+	ip := net.ParseIP(value)
+	if ip == nil {
+		return nil, fmt.Errorf("%w: %q is not an IP address", ErrNoAddress, value)
+	}
+	if !matchesFamily(ip, family) {
+		return nil, fmt.Errorf("%w: %s is not an %s address", ErrNoAddress, value, family)
+	}
+	return ip, nil
+}
+
+func matchesFamily(ip net.IP, family Family) bool {
+	if family == FamilyIPv4 {
+		return ip.To4() != nil
+	}
+	return ip.To4() == nil && ip.To16() != nil
+}
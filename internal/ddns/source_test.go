@@ -0,0 +1,136 @@
+package ddns
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHTTPSource_Detect(t *testing.T) {
+	tests := []struct {
+		name       string
+		family     Family
+		statusCode int
+		body       string
+		want       string
+		wantErr    assert.ErrorAssertionFunc
+	}{
+		{
+			name:       "it should read an IPv4 address",
+			family:     FamilyIPv4,
+			statusCode: http.StatusOK,
+			body:       "203.0.113.7\n",
+			want:       "203.0.113.7",
+			wantErr:    assert.NoError,
+		},
+		{
+			name:       "it should read an IPv6 address",
+			family:     FamilyIPv6,
+			statusCode: http.StatusOK,
+			body:       "2001:db8::1",
+			want:       "2001:db8::1",
+			wantErr:    assert.NoError,
+		},
+		{
+			name:       "it should reject an address of the wrong family",
+			family:     FamilyIPv6,
+			statusCode: http.StatusOK,
+			body:       "203.0.113.7",
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, ErrNoAddress)
+			},
+		},
+		{
+			name:       "it should reject a body that is not an address",
+			family:     FamilyIPv4,
+			statusCode: http.StatusOK,
+			body:       "<html></html>",
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, ErrNoAddress)
+			},
+		},
+		{
+			name:       "it should fail on a non 200 response",
+			family:     FamilyIPv4,
+			statusCode: http.StatusBadGateway,
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, ErrNoAddress)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.statusCode)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			got, err := HTTPSource{Client: server.Client(), URL: server.URL}.Detect(context.Background(), tt.family)
+			if !tt.wantErr(t, err) || err != nil {
+				return
+			}
+			assert.Equal(t, tt.want, got.String())
+		})
+	}
+}
+
+func TestCommandSource_Detect(t *testing.T) {
+	got, err := CommandSource{Command: "echo 198.51.100.4"}.Detect(context.Background(), FamilyIPv4)
+	assert.NoError(t, err)
+	assert.Equal(t, "198.51.100.4", got.String())
+
+	_, err = CommandSource{Command: "exit 1"}.Detect(context.Background(), FamilyIPv4)
+	assert.ErrorIs(t, err, ErrNoAddress)
+}
+
+func TestParseSource(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    string
+		want    Source
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "it should parse an interface source",
+			spec:    "interface:eth0",
+			want:    InterfaceSource{Name: "eth0"},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "it should parse a command source",
+			spec:    "command:dig +short myip.opendns.com @resolver1.opendns.com",
+			want:    CommandSource{Command: "dig +short myip.opendns.com @resolver1.opendns.com"},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "it should reject an empty interface name",
+			spec: "interface:",
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, ErrInvalidSource)
+			},
+		},
+		{
+			name: "it should reject an unknown source",
+			spec: "ftp://example.com",
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, ErrInvalidSource)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseSource(tt.spec)
+			if !tt.wantErr(t, err) || err != nil {
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	got, err := ParseSource("https://api.ipify.org")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://api.ipify.org", got.(HTTPSource).URL)
+}
@@ -0,0 +1,186 @@
+package ddns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+	"net"
+	"time"
+)
+
+type Action string
+
+const (
+	ActionUnchanged Action = "unchanged"
+	ActionCreated   Action = "created"
+	ActionUpdated   Action = "updated"
+)
+
+var (
+	ErrAmbiguousRecord = errors.New("multiple records match")
+	ErrSyncFailed      = errors.New("dynamic DNS update failed")
+)
+
+// Result describes the outcome of one synchronization of a record.
+type Result struct {
+	Name   string
+	Type   cloudflare.ZoneType
+	IP     net.IP
+	Action Action
+	Err    error
+}
+
+// Updater keeps the A or AAAA record of a name pointing at the address detected by its source.
+type Updater struct {
+	Client  cloudflare.CloudflareClient
+	Zone    string
+	ZoneID  string
+	Name    string
+	Family  Family
+	Source  Source
+	TTL     int
+	Proxied bool
+}
+
+func (u *Updater) recordType() cloudflare.ZoneType {
+	if u.Family == FamilyIPv6 {
+		return cloudflare.ZoneTypeAAAA
+	}
+	return cloudflare.ZoneTypeA
+}
+
+// Sync detects the current address and creates or updates the record only when it differs from the live one.
+func (u *Updater) Sync(ctx context.Context) Result {
+	result := Result{Name: u.Name, Type: u.recordType()}
+
+	ip, err := u.Source.Detect(ctx, u.Family)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	result.IP = ip
+
+	// Every record of the name is fetched, not only the address ones, so a new record is validated against a CNAME.
+	named, err := u.Client.GetZoneRecords(ctx, cloudflare.GetZoneRecordsRequest{
+		ZoneID: u.ZoneID,
+		Name:   u.Name,
+	})
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	var records []cloudflare.ZoneRecord
+	for _, record := range named.Records {
+		if record.Type == result.Type {
+			records = append(records, record)
+		}
+	}
+
+	switch len(records) {
+	case 0:
+		record := cloudflare.ZoneRecordRequest{
+			Type:    result.Type,
+			Name:    u.Name,
+			Content: ip.String(),
+			TTL:     u.TTL,
+			Proxied: u.Proxied,
+			Tags:    []string{},
+		}
+		if err := cloudflare.ValidateZoneRecord(u.Zone, record, named.Records); err != nil {
+			result.Err = err
+			return result
+		}
+		_, result.Err = u.Client.AddZoneRecord(ctx, cloudflare.AddZoneRecordRequest{
+			ZoneID: u.ZoneID,
+			Record: record,
+		})
+		result.Action = ActionCreated
+	case 1:
+		current := records[0]
+		if net.ParseIP(current.Content).Equal(ip) {
+			result.Action = ActionUnchanged
+			return result
+		}
+		content := ip.String()
+		_, result.Err = u.Client.PatchZoneRecord(ctx, cloudflare.PatchZoneRecordRequest{
+			ZoneID:   u.ZoneID,
+			RecordID: current.ID,
+			Record:   cloudflare.ZoneRecordPatch{Content: &content},
+		})
+		result.Action = ActionUpdated
+	default:
+		result.Err = fmt.Errorf("%w: %d %s records exist for %s", ErrAmbiguousRecord, len(records), result.Type, u.Name)
+	}
+
+	return result
+}
+
+// Backoff returns the delay before the next attempt after consecutive failures, doubling from initial up to max.
+func Backoff(failures int, initial, max time.Duration) time.Duration {
+	delay := initial
+	for i := 1; i < failures && delay < max; i++ {
+		delay *= 2
+	}
+	if delay > max {
+		return max
+	}
+	return delay
+}
+
+const (
+	DefaultInterval       = 5 * time.Minute
+	DefaultInitialBackoff = 10 * time.Second
+	DefaultMaxBackoff     = 10 * time.Minute
+)
+
+// Options controls the schedule of Run.
+type Options struct {
+	Interval       time.Duration
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Once           bool
+}
+
+// Run synchronizes every updater each interval until ctx is cancelled, retrying failed rounds with exponential backoff.
+// report is called with the result of every synchronization.
+func Run(ctx context.Context, updaters []*Updater, options Options, report func(Result)) error {
+	failures := 0
+	for {
+		var failure error
+		for _, updater := range updaters {
+			result := updater.Sync(ctx)
+			if ctx.Err() != nil {
+				return nil
+			}
+			report(result)
+			if result.Err != nil && failure == nil {
+				failure = result.Err
+			}
+		}
+		failed := failure != nil
+
+		if options.Once {
+			if failed {
+				return fmt.Errorf("%w: %w", ErrSyncFailed, failure)
+			}
+			return nil
+		}
+
+		delay := options.Interval
+		if failed {
+			failures++
+			delay = Backoff(failures, options.InitialBackoff, options.MaxBackoff)
+		} else {
+			failures = 0
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package ddns
+
+import (
+	"context"
+	"errors"
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+	"github.com/stretchr/testify/assert"
+	"net"
+	"testing"
+	"time"
+)
+
+type fakeSource struct {
+	ip  string
+	err error
+}
+
+func (f fakeSource) Detect(_ context.Context, _ Family) (net.IP, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return net.ParseIP(f.ip), nil
+}
+
+type fakeClient struct {
+	cloudflare.CloudflareClient
+	records []cloudflare.ZoneRecord
+	calls   []string
+}
+
+func (f *fakeClient) GetZoneRecords(_ context.Context, request cloudflare.GetZoneRecordsRequest) (*cloudflare.GetZoneRecordsResponse, error) {
+	f.calls = append(f.calls, "get "+request.Name)
+	return &cloudflare.GetZoneRecordsResponse{Records: f.records}, nil
+}
+
+func (f *fakeClient) AddZoneRecord(_ context.Context, request cloudflare.AddZoneRecordRequest) (*cloudflare.AddZoneRecordResponse, error) {
+	f.calls = append(f.calls, "add "+string(request.Record.Type)+" "+request.Record.Content)
+	return &cloudflare.AddZoneRecordResponse{}, nil
+}
+
+func (f *fakeClient) PatchZoneRecord(_ context.Context, request cloudflare.PatchZoneRecordRequest) (*cloudflare.PatchZoneRecordResponse, error) {
+	f.calls = append(f.calls, "patch "+request.RecordID+" "+*request.Record.Content)
+	return &cloudflare.PatchZoneRecordResponse{}, nil
+}
+
+func TestUpdater_Sync(t *testing.T) {
+	tests := []struct {
+		name       string
+		family     Family
+		source     Source
+		records    []cloudflare.ZoneRecord
+		wantAction Action
+		wantCalls  []string
+		wantErr    error
+	}{
+		{
+			name:       "it should not touch a record that is up to date",
+			family:     FamilyIPv4,
+			source:     fakeSource{ip: "203.0.113.7"},
+			records:    []cloudflare.ZoneRecord{{ID: "1", Type: cloudflare.ZoneTypeA, Content: "203.0.113.7"}},
+			wantAction: ActionUnchanged,
+			wantCalls:  []string{"get home.example.com"},
+		},
+		{
+			name:       "it should patch a record whose address changed",
+			family:     FamilyIPv4,
+			source:     fakeSource{ip: "203.0.113.8"},
+			records:    []cloudflare.ZoneRecord{{ID: "1", Type: cloudflare.ZoneTypeA, Content: "203.0.113.7"}},
+			wantAction: ActionUpdated,
+			wantCalls:  []string{"get home.example.com", "patch 1 203.0.113.8"},
+		},
+		{
+			name:       "it should create a missing AAAA record next to the A record",
+			family:     FamilyIPv6,
+			source:     fakeSource{ip: "2001:db8::1"},
+			records:    []cloudflare.ZoneRecord{{ID: "1", Type: cloudflare.ZoneTypeA, Name: "home.example.com", Content: "203.0.113.7"}},
+			wantAction: ActionCreated,
+			wantCalls:  []string{"get home.example.com", "add AAAA 2001:db8::1"},
+		},
+		{
+			name:    "it should refuse to pick between several records",
+			family:  FamilyIPv4,
+			source:  fakeSource{ip: "203.0.113.7"},
+			records: []cloudflare.ZoneRecord{{ID: "1", Type: cloudflare.ZoneTypeA}, {ID: "2", Type: cloudflare.ZoneTypeA}},
+			wantCalls: []string{
+				"get home.example.com",
+			},
+			wantErr: ErrAmbiguousRecord,
+		},
+		{
+			name:    "it should not create a record next to a CNAME",
+			family:  FamilyIPv4,
+			source:  fakeSource{ip: "203.0.113.7"},
+			records: []cloudflare.ZoneRecord{{ID: "1", Type: cloudflare.ZoneTypeCNAME, Name: "home.example.com", Content: "example.net"}},
+			wantCalls: []string{
+				"get home.example.com",
+			},
+			wantErr: cloudflare.ErrInvalidRecord,
+		},
+		{
+			name:    "it should not call the API when detection fails",
+			family:  FamilyIPv4,
+			source:  fakeSource{err: ErrNoAddress},
+			wantErr: ErrNoAddress,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &fakeClient{records: tt.records}
+			updater := &Updater{
+				Client: client,
+				Zone:   "example.com",
+				ZoneID: "zone-id",
+				Name:   "home.example.com",
+				Family: tt.family,
+				Source: tt.source,
+				TTL:    1,
+			}
+
+			result := updater.Sync(context.Background())
+			assert.Equal(t, tt.wantCalls, client.calls)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(result.Err, tt.wantErr))
+				return
+			}
+			assert.NoError(t, result.Err)
+			assert.Equal(t, tt.wantAction, result.Action)
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, 10*time.Second, Backoff(1, 10*time.Second, time.Minute))
+	assert.Equal(t, 20*time.Second, Backoff(2, 10*time.Second, time.Minute))
+	assert.Equal(t, 40*time.Second, Backoff(3, 10*time.Second, time.Minute))
+	assert.Equal(t, time.Minute, Backoff(4, 10*time.Second, time.Minute))
+	assert.Equal(t, time.Minute, Backoff(100, 10*time.Second, time.Minute))
+}
+
+func TestRun(t *testing.T) {
+	t.Run("it should report every updater and exit when run once", func(t *testing.T) {
+		client := &fakeClient{records: []cloudflare.ZoneRecord{{ID: "1", Type: cloudflare.ZoneTypeA, Content: "203.0.113.7"}}}
+		updater := &Updater{Client: client, Name: "home.example.com", Family: FamilyIPv4, Source: fakeSource{ip: "203.0.113.7"}}
+
+		var results []Result
+		err := Run(context.Background(), []*Updater{updater}, Options{Once: true}, func(result Result) {
+			results = append(results, result)
+		})
+		assert.NoError(t, err)
+		assert.Len(t, results, 1)
+	})
+
+	t.Run("it should fail when run once and an update fails", func(t *testing.T) {
+		updater := &Updater{Client: &fakeClient{}, Family: FamilyIPv4, Source: fakeSource{err: ErrNoAddress}}
+
+		err := Run(context.Background(), []*Updater{updater}, Options{Once: true}, func(Result) {})
+		assert.ErrorIs(t, err, ErrSyncFailed)
+	})
+
+	t.Run("it should stop gracefully when the context is cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		client := &fakeClient{records: []cloudflare.ZoneRecord{{ID: "1", Type: cloudflare.ZoneTypeA, Content: "203.0.113.7"}}}
+		updater := &Updater{Client: client, Name: "home.example.com", Family: FamilyIPv4, Source: fakeSource{ip: "203.0.113.7"}}
+
+		rounds := 0
+		err := Run(ctx, []*Updater{updater}, Options{Interval: time.Millisecond, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}, func(Result) {
+			rounds++
+			if rounds == 3 {
+				cancel()
+			}
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, rounds)
+	})
+}
@@ -0,0 +1,183 @@
+package dryrun
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// RecordID is the ID given to the records and zones the dry-run client pretends to create.
+const RecordID = "dry-run"
+
+// client passes reads through to the wrapped client and prints writes instead of sending them, answering with
+// what the API would most likely return. It implements every method explicitly rather than embedding the wrapped
+// client, so a write added to CloudflareClient cannot reach the API until it is handled here.
+type client struct {
+	next cloudflare.CloudflareClient
+	out  io.Writer
+	mu   sync.Mutex
+}
+
+func NewClient(next cloudflare.CloudflareClient, out io.Writer) cloudflare.CloudflareClient {
+	return &client{
+		next: next,
+		out:  out,
+	}
+}
+
+func (c *client) record(method, path string, body interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	line := fmt.Sprintf("[dry-run] %s %s", method, path)
+	if body != nil {
+		raw, err := json.Marshal(body)
+		if err == nil {
+			line += " " + string(raw)
+		}
+	}
+	_, _ = fmt.Fprintln(c.out, line)
+}
+
+func (c *client) GetZoneByDomain(ctx context.Context, request cloudflare.GetZoneByDomainRequest) (*cloudflare.GetZoneByDomainResponse, error) {
+	return c.next.GetZoneByDomain(ctx, request)
+}
+
+func (c *client) ListZones(ctx context.Context, request cloudflare.ListZonesRequest) (*cloudflare.ListZonesResponse, error) {
+	return c.next.ListZones(ctx, request)
+}
+
+func (c *client) GetZone(ctx context.Context, request cloudflare.GetZoneRequest) (*cloudflare.GetZoneResponse, error) {
+	return c.next.GetZone(ctx, request)
+}
+
+func (c *client) CreateZone(_ context.Context, request cloudflare.CreateZoneRequest) (*cloudflare.CreateZoneResponse, error) {
+	c.record(http.MethodPost, "/zones", map[string]interface{}{
+		"name":    request.Name,
+		"account": map[string]string{"id": request.AccountID},
+		"type":    request.Type,
+	})
+	return &cloudflare.CreateZoneResponse{Zone: cloudflare.Zone{
+		ID:      RecordID,
+		Name:    request.Name,
+		Status:  "pending",
+		Type:    string(request.Type),
+		Account: cloudflare.ZoneAccount{ID: request.AccountID},
+	}}, nil
+}
+
+// PatchZone reads the zone so the answer reflects it with the patch applied.
+func (c *client) PatchZone(ctx context.Context, request cloudflare.PatchZoneRequest) (*cloudflare.PatchZoneResponse, error) {
+	current, err := c.next.GetZone(ctx, cloudflare.GetZoneRequest{ZoneID: request.ZoneID})
+	if err != nil {
+		return nil, err
+	}
+
+	c.record(http.MethodPatch, fmt.Sprintf("/zones/%s", request.ZoneID), request)
+	zone := current.Zone
+	if request.Paused != nil {
+		zone.Paused = *request.Paused
+	}
+	return &cloudflare.PatchZoneResponse{Zone: zone}, nil
+}
+
+func (c *client) DeleteZone(_ context.Context, request cloudflare.DeleteZoneRequest) error {
+	c.record(http.MethodDelete, fmt.Sprintf("/zones/%s", request.ZoneID), nil)
+	return nil
+}
+
+func (c *client) ZoneActivationCheck(_ context.Context, request cloudflare.ZoneActivationCheckRequest) error {
+	c.record(http.MethodPut, fmt.Sprintf("/zones/%s/activation_check", request.ZoneID), nil)
+	return nil
+}
+
+func (c *client) ListZoneSettings(ctx context.Context, request cloudflare.ListZoneSettingsRequest) (*cloudflare.ListZoneSettingsResponse, error) {
+	return c.next.ListZoneSettings(ctx, request)
+}
+
+func (c *client) GetZoneSetting(ctx context.Context, request cloudflare.GetZoneSettingRequest) (*cloudflare.GetZoneSettingResponse, error) {
+	return c.next.GetZoneSetting(ctx, request)
+}
+
+// UpdateZoneSetting reads the setting first, so an unknown setting fails as it would without --dry-run.
+func (c *client) UpdateZoneSetting(ctx context.Context, request cloudflare.UpdateZoneSettingRequest) (*cloudflare.UpdateZoneSettingResponse, error) {
+	current, err := c.next.GetZoneSetting(ctx, cloudflare.GetZoneSettingRequest{ZoneID: request.ZoneID, SettingID: request.SettingID})
+	if err != nil {
+		return nil, err
+	}
+
+	c.record(http.MethodPatch, fmt.Sprintf("/zones/%s/settings/%s", request.ZoneID, request.SettingID), request)
+	setting := current.Setting
+	setting.Value = request.Value
+	return &cloudflare.UpdateZoneSettingResponse{Setting: setting}, nil
+}
+
+func (c *client) PurgeCache(_ context.Context, request cloudflare.PurgeCacheRequest) (*cloudflare.PurgeCacheResponse, error) {
+	c.record(http.MethodPost, fmt.Sprintf("/zones/%s/purge_cache", request.ZoneID), request)
+	return &cloudflare.PurgeCacheResponse{Purge: cloudflare.CachePurge{ID: RecordID}}, nil
+}
+
+func (c *client) GetZoneRecords(ctx context.Context, request cloudflare.GetZoneRecordsRequest) (*cloudflare.GetZoneRecordsResponse, error) {
+	return c.next.GetZoneRecords(ctx, request)
+}
+
+func (c *client) GetZoneRecord(ctx context.Context, request cloudflare.GetZoneRecordRequest) (*cloudflare.GetZoneRecordResponse, error) {
+	return c.next.GetZoneRecord(ctx, request)
+}
+
+func (c *client) AddZoneRecord(_ context.Context, request cloudflare.AddZoneRecordRequest) (*cloudflare.AddZoneRecordResponse, error) {
+	c.record(http.MethodPost, fmt.Sprintf("/zones/%s/dns_records", request.ZoneID), request.Record)
+	record := request.Record
+	record.ID = RecordID
+	return &cloudflare.AddZoneRecordResponse{Record: toZoneRecord(request.ZoneID, record)}, nil
+}
+
+func (c *client) UpdateZoneRecord(_ context.Context, request cloudflare.UpdateZoneRecordRequest) (*cloudflare.UpdateZoneRecordResponse, error) {
+	c.record(http.MethodPut, fmt.Sprintf("/zones/%s/dns_records/%s", request.ZoneID, request.RecordID), request.Record)
+	record := request.Record
+	record.ID = request.RecordID
+	return &cloudflare.UpdateZoneRecordResponse{Record: toZoneRecord(request.ZoneID, record)}, nil
+}
+
+func (c *client) PatchZoneRecord(_ context.Context, request cloudflare.PatchZoneRecordRequest) (*cloudflare.PatchZoneRecordResponse, error) {
+	c.record(http.MethodPatch, fmt.Sprintf("/zones/%s/dns_records/%s", request.ZoneID, request.RecordID), request.Record)
+	record := request.Record.ApplyTo(cloudflare.ZoneRecord{ID: request.RecordID})
+	return &cloudflare.PatchZoneRecordResponse{Record: toZoneRecord(request.ZoneID, record)}, nil
+}
+
+func (c *client) DeleteZoneRecord(_ context.Context, request cloudflare.DeleteZoneRecordRequest) error {
+	c.record(http.MethodDelete, fmt.Sprintf("/zones/%s/dns_records/%s", request.ZoneID, request.RecordID), nil)
+	return nil
+}
+
+func (c *client) VerifyToken(ctx context.Context) (*cloudflare.VerifyTokenResponse, error) {
+	return c.next.VerifyToken(ctx)
+}
+
+func (c *client) GetToken(ctx context.Context, request cloudflare.GetTokenRequest) (*cloudflare.GetTokenResponse, error) {
+	return c.next.GetToken(ctx, request)
+}
+
+func (c *client) GetUser(ctx context.Context) (*cloudflare.GetUserResponse, error) {
+	return c.next.GetUser(ctx)
+}
+
+func toZoneRecord(zoneID string, request cloudflare.ZoneRecordRequest) cloudflare.ZoneRecord {
+	return cloudflare.ZoneRecord{
+		ID:       request.ID,
+		Name:     request.Name,
+		Type:     request.Type,
+		Content:  request.Content,
+		Priority: request.Priority,
+		Data:     request.Data,
+		Proxied:  request.Proxied,
+		TTL:      request.TTL,
+		Comment:  request.Comment,
+		ZoneID:   zoneID,
+		Tags:     request.Tags,
+	}
+}
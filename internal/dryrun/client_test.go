@@ -0,0 +1,88 @@
+package dryrun
+
+import (
+	"bytes"
+	"context"
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+// fakeClient only answers reads, any write reaching it panics on the nil embedded client.
+type fakeClient struct {
+	cloudflare.CloudflareClient
+}
+
+func (f *fakeClient) GetZoneByDomain(_ context.Context, _ cloudflare.GetZoneByDomainRequest) (*cloudflare.GetZoneByDomainResponse, error) {
+	return &cloudflare.GetZoneByDomainResponse{ZoneID: "zone-id"}, nil
+}
+
+func (f *fakeClient) GetZone(_ context.Context, request cloudflare.GetZoneRequest) (*cloudflare.GetZoneResponse, error) {
+	return &cloudflare.GetZoneResponse{Zone: cloudflare.Zone{ID: request.ZoneID, Name: "example.com"}}, nil
+}
+
+func (f *fakeClient) GetZoneSetting(_ context.Context, request cloudflare.GetZoneSettingRequest) (*cloudflare.GetZoneSettingResponse, error) {
+	if request.SettingID != "ssl" {
+		return nil, cloudflare.ErrSettingNotFound
+	}
+	return &cloudflare.GetZoneSettingResponse{Setting: cloudflare.ZoneSetting{ID: "ssl", Value: "full", Editable: true}}, nil
+}
+
+func TestClient_Reads(t *testing.T) {
+	var out bytes.Buffer
+	c := NewClient(&fakeClient{}, &out)
+
+	zone, err := c.GetZoneByDomain(context.Background(), cloudflare.GetZoneByDomainRequest{Domain: "example.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, "zone-id", zone.ZoneID)
+	assert.Empty(t, out.String(), "reads should not be printed")
+}
+
+func TestClient_Writes(t *testing.T) {
+	var out bytes.Buffer
+	c := NewClient(&fakeClient{}, &out)
+	ctx := context.Background()
+
+	added, err := c.AddZoneRecord(ctx, cloudflare.AddZoneRecordRequest{
+		ZoneID: "zone-id",
+		Record: cloudflare.ZoneRecordRequest{Type: cloudflare.ZoneTypeA, Name: "www.example.com", Content: "1.1.1.1", TTL: 1},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, cloudflare.ZoneRecord{ID: RecordID, Type: cloudflare.ZoneTypeA, Name: "www.example.com", Content: "1.1.1.1", TTL: 1, ZoneID: "zone-id"}, added.Record)
+
+	content := "2.2.2.2"
+	patched, err := c.PatchZoneRecord(ctx, cloudflare.PatchZoneRecordRequest{
+		ZoneID:   "zone-id",
+		RecordID: "record-id",
+		Record:   cloudflare.ZoneRecordPatch{Content: &content},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "record-id", patched.Record.ID)
+	assert.Equal(t, "2.2.2.2", patched.Record.Content)
+
+	assert.NoError(t, c.DeleteZoneRecord(ctx, cloudflare.DeleteZoneRecordRequest{ZoneID: "zone-id", RecordID: "record-id"}))
+
+	paused := true
+	zone, err := c.PatchZone(ctx, cloudflare.PatchZoneRequest{ZoneID: "zone-id", Paused: &paused})
+	assert.NoError(t, err)
+	assert.Equal(t, cloudflare.Zone{ID: "zone-id", Name: "example.com", Paused: true}, zone.Zone)
+
+	setting, err := c.UpdateZoneSetting(ctx, cloudflare.UpdateZoneSettingRequest{ZoneID: "zone-id", SettingID: "ssl", Value: "strict"})
+	assert.NoError(t, err)
+	assert.Equal(t, "strict", setting.Setting.Value)
+	_, err = c.UpdateZoneSetting(ctx, cloudflare.UpdateZoneSettingRequest{ZoneID: "zone-id", SettingID: "bogus", Value: "on"})
+	assert.ErrorIs(t, err, cloudflare.ErrSettingNotFound)
+
+	_, err = c.PurgeCache(ctx, cloudflare.PurgeCacheRequest{ZoneID: "zone-id", PurgeEverything: true})
+	assert.NoError(t, err)
+
+	assert.Equal(t, strings.Join([]string{
+		`[dry-run] POST /zones/zone-id/dns_records {"type":"A","name":"www.example.com","content":"1.1.1.1","proxied":false,"ttl":1,"tags":null,"comment":""}`,
+		`[dry-run] PATCH /zones/zone-id/dns_records/record-id {"content":"2.2.2.2"}`,
+		`[dry-run] DELETE /zones/zone-id/dns_records/record-id`,
+		`[dry-run] PATCH /zones/zone-id {"paused":true}`,
+		`[dry-run] PATCH /zones/zone-id/settings/ssl {"value":"strict"}`,
+		`[dry-run] POST /zones/zone-id/purge_cache {"purge_everything":true}`,
+	}, "\n")+"\n", out.String(), "it should print every write instead of sending it")
+}
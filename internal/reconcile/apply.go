@@ -0,0 +1,57 @@
+package reconcile
+
+import (
+	"context"
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+)
+
+// Result is the outcome of applying a single change.
+type Result struct {
+	Change Change                 `json:"change"`
+	Record *cloudflare.ZoneRecord `json:"record,omitempty"`
+	Error  string                 `json:"error,omitempty"`
+	Err    error                  `json:"-"`
+}
+
+// Apply executes the plan in order and stops at the first failing change.
+// The returned results cover every change that was attempted, including the failed one.
+func Apply(ctx context.Context, client cloudflare.CloudflareClient, zoneID string, plan Plan) ([]Result, error) {
+	var results []Result
+	for _, change := range plan.Changes {
+		result := Result{Change: change}
+		switch change.Action {
+		case ActionDelete:
+			result.Err = client.DeleteZoneRecord(ctx, cloudflare.DeleteZoneRecordRequest{
+				ZoneID:   zoneID,
+				RecordID: change.Current.ID,
+			})
+		case ActionUpdate:
+			var response *cloudflare.UpdateZoneRecordResponse
+			response, result.Err = client.UpdateZoneRecord(ctx, cloudflare.UpdateZoneRecordRequest{
+				ZoneID:   zoneID,
+				RecordID: change.Current.ID,
+				Record:   *change.Desired,
+			})
+			if result.Err == nil {
+				result.Record = &response.Record
+			}
+		case ActionCreate:
+			var response *cloudflare.AddZoneRecordResponse
+			response, result.Err = client.AddZoneRecord(ctx, cloudflare.AddZoneRecordRequest{
+				ZoneID: zoneID,
+				Record: *change.Desired,
+			})
+			if result.Err == nil {
+				result.Record = &response.Record
+			}
+		}
+
+		if result.Err != nil {
+			result.Error = result.Err.Error()
+			return append(results, result), result.Err
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
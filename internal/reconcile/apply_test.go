@@ -0,0 +1,72 @@
+package reconcile
+
+import (
+	"context"
+	"errors"
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type fakeClient struct {
+	cloudflare.CloudflareClient
+	calls     []string
+	deleteErr error
+}
+
+func (f *fakeClient) AddZoneRecord(_ context.Context, request cloudflare.AddZoneRecordRequest) (*cloudflare.AddZoneRecordResponse, error) {
+	f.calls = append(f.calls, "add "+request.Record.Name)
+	return &cloudflare.AddZoneRecordResponse{Record: cloudflare.ZoneRecord{ID: "new", Name: request.Record.Name}}, nil
+}
+
+func (f *fakeClient) UpdateZoneRecord(_ context.Context, request cloudflare.UpdateZoneRecordRequest) (*cloudflare.UpdateZoneRecordResponse, error) {
+	f.calls = append(f.calls, "update "+request.RecordID)
+	return &cloudflare.UpdateZoneRecordResponse{Record: cloudflare.ZoneRecord{ID: request.RecordID}}, nil
+}
+
+func (f *fakeClient) DeleteZoneRecord(_ context.Context, request cloudflare.DeleteZoneRecordRequest) error {
+	f.calls = append(f.calls, "delete "+request.RecordID)
+	return f.deleteErr
+}
+
+func TestApply(t *testing.T) {
+	plan := Plan{
+		Changes: []Change{
+			{Action: ActionDelete, Current: &cloudflare.ZoneRecord{ID: "old"}},
+			{Action: ActionUpdate, Current: &cloudflare.ZoneRecord{ID: "kept"}, Desired: &cloudflare.ZoneRecordRequest{ID: "kept"}},
+			{Action: ActionCreate, Desired: &cloudflare.ZoneRecordRequest{Name: "new.example.com"}},
+		},
+	}
+	tests := []struct {
+		name      string
+		deleteErr error
+		wantCalls []string
+		wantErr   assert.ErrorAssertionFunc
+	}{
+		{
+			name:      "it should apply every change in order",
+			wantCalls: []string{"delete old", "update kept", "add new.example.com"},
+			wantErr:   assert.NoError,
+		},
+		{
+			name:      "it should stop at the first failure",
+			deleteErr: cloudflare.ErrRecordDeleteFailed,
+			wantCalls: []string{"delete old"},
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, cloudflare.ErrRecordDeleteFailed)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &fakeClient{deleteErr: tt.deleteErr}
+			results, err := Apply(context.Background(), client, "zone-id", plan)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantCalls, client.calls)
+			assert.Len(t, results, len(tt.wantCalls))
+			if tt.deleteErr != nil {
+				assert.True(t, errors.Is(results[0].Err, tt.deleteErr))
+			}
+		})
+	}
+}
@@ -0,0 +1,223 @@
+package reconcile
+
+import (
+	"fmt"
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+	"sort"
+	"strings"
+)
+
+type Action string
+
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+// Change is a single operation needed to bring a zone to its desired state.
+// Current is nil for creates and Desired is nil for deletes.
+type Change struct {
+	Action  Action                        `json:"action"`
+	Current *cloudflare.ZoneRecord        `json:"current,omitempty"`
+	Desired *cloudflare.ZoneRecordRequest `json:"desired,omitempty"`
+	Diff    []string                      `json:"diff,omitempty"`
+}
+
+// Type returns the record type affected by the change.
+func (c Change) Type() cloudflare.ZoneType {
+	if c.Desired != nil {
+		return c.Desired.Type
+	}
+	return c.Current.Type
+}
+
+// Name returns the fully qualified name affected by the change.
+func (c Change) Name() string {
+	if c.Desired != nil {
+		return c.Desired.Name
+	}
+	return c.Current.Name
+}
+
+// Content returns the record content affected by the change, or its data for structured types.
+func (c Change) Content() string {
+	if c.Desired != nil {
+		if c.Desired.Data != nil {
+			return c.Desired.Data.String()
+		}
+		return c.Desired.Content
+	}
+	return c.Current.Content
+}
+
+type Plan struct {
+	Changes   []Change `json:"changes"`
+	Unmanaged int      `json:"unmanaged"`
+}
+
+func (p Plan) HasChanges() bool {
+	return len(p.Changes) > 0
+}
+
+// Count returns the number of changes with the given action.
+func (p Plan) Count(action Action) int {
+	count := 0
+	for _, change := range p.Changes {
+		if change.Action == action {
+			count++
+		}
+	}
+	return count
+}
+
+// ComputePlan diffs the live records of a zone against its desired state.
+// Records are identified by name, type and content (data for structured types), so a content change is planned as a delete plus a create.
+// Live records missing from the desired state are deleted only when purge is set.
+// Created and updated records are validated against the state the zone will have once the plan is applied.
+func ComputePlan(domain string, desired DesiredState, current []cloudflare.ZoneRecord, purge bool) (Plan, error) {
+	wanted := map[string]cloudflare.ZoneRecordRequest{}
+	var order []string
+	for _, record := range desired.Records {
+		request := cloudflare.ZoneRecordRequest{
+			Type:     record.Type,
+			Name:     acquireFullName(domain, record.Name),
+			Content:  record.Content,
+			Priority: record.Priority,
+			Data:     record.Data,
+			TTL:      record.TTL,
+			Proxied:  record.Proxied,
+			Tags:     record.Tags,
+			Comment:  record.Comment,
+		}
+		if request.TTL == 0 {
+			request.TTL = 1
+		}
+		if request.Tags == nil {
+			request.Tags = []string{}
+		}
+
+		key := cloudflare.RecordIdentity(request.Name, request.Type, request.Content, request.Data)
+		if _, ok := wanted[key]; ok {
+			return Plan{}, fmt.Errorf("%w: duplicate record %s %s %s", ErrInvalidDesiredState, request.Name, request.Type, request.Content)
+		}
+		wanted[key] = request
+		order = append(order, key)
+	}
+
+	var plan Plan
+	var deletes, updates []Change
+	var final []cloudflare.ZoneRecord
+	matched := map[string]bool{}
+	for i := range current {
+		record := current[i]
+		key := cloudflare.RecordIdentity(record.Name, record.Type, record.Content, record.Data)
+		request, ok := wanted[key]
+		if !ok || matched[key] {
+			if purge {
+				deletes = append(deletes, Change{Action: ActionDelete, Current: &record})
+			} else {
+				plan.Unmanaged++
+				final = append(final, record)
+			}
+			continue
+		}
+
+		matched[key] = true
+		request.ID = record.ID
+		if diff := diffRecord(record, request); len(diff) > 0 {
+			updates = append(updates, Change{Action: ActionUpdate, Current: &record, Desired: &request, Diff: diff})
+		}
+	}
+
+	var creates []Change
+	for _, key := range order {
+		if matched[key] {
+			continue
+		}
+		request := wanted[key]
+		creates = append(creates, Change{Action: ActionCreate, Desired: &request})
+	}
+
+	for _, change := range append(updates, creates...) {
+		others := append([]cloudflare.ZoneRecord{}, final...)
+		for _, key := range order {
+			request := wanted[key]
+			if key != cloudflare.RecordIdentity(change.Desired.Name, change.Desired.Type, change.Desired.Content, change.Desired.Data) {
+				others = append(others, cloudflare.ZoneRecord{Name: request.Name, Type: request.Type})
+			}
+		}
+		if err := cloudflare.ValidateZoneRecord(domain, *change.Desired, others); err != nil {
+			return Plan{}, err
+		}
+	}
+
+	// Deletes go first so a create never collides with a record it replaces, e.g. a CNAME replacing an A record.
+	plan.Changes = append(plan.Changes, deletes...)
+	plan.Changes = append(plan.Changes, updates...)
+	plan.Changes = append(plan.Changes, creates...)
+	return plan, nil
+}
+
+func acquireFullName(domain, name string) string {
+	name = strings.TrimSuffix(strings.ToLower(name), ".")
+	domain = strings.ToLower(domain)
+	if name == "@" || name == "" {
+		return domain
+	}
+	if name == domain || strings.HasSuffix(name, "."+domain) {
+		return name
+	}
+	return name + "." + domain
+}
+
+func diffRecord(current cloudflare.ZoneRecord, desired cloudflare.ZoneRecordRequest) []string {
+	var diff []string
+	if current.TTL != desired.TTL {
+		diff = append(diff, fmt.Sprintf("ttl: %d -> %d", current.TTL, desired.TTL))
+	}
+	if !samePriority(current.Priority, desired.Priority) {
+		diff = append(diff, fmt.Sprintf("priority: %s -> %s", formatPriority(current.Priority), formatPriority(desired.Priority)))
+	}
+	if current.Proxied != desired.Proxied {
+		diff = append(diff, fmt.Sprintf("proxied: %t -> %t", current.Proxied, desired.Proxied))
+	}
+	if current.Comment != desired.Comment {
+		diff = append(diff, fmt.Sprintf("comment: %q -> %q", current.Comment, desired.Comment))
+	}
+	if !sameTags(current.Tags, desired.Tags) {
+		diff = append(diff, fmt.Sprintf("tags: %v -> %v", current.Tags, desired.Tags))
+	}
+	return diff
+}
+
+func samePriority(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func formatPriority(priority *int) string {
+	if priority == nil {
+		return "none"
+	}
+	return fmt.Sprint(*priority)
+}
+
+func sameTags(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	sortedA := append([]string{}, a...)
+	sortedB := append([]string{}, b...)
+	sort.Strings(sortedA)
+	sort.Strings(sortedB)
+	for i := range sortedA {
+		if sortedA[i] != sortedB[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,203 @@
+package reconcile
+
+import (
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestComputePlan(t *testing.T) {
+	mxPriority := 10
+	current := []cloudflare.ZoneRecord{
+		{ID: "a", Name: "example.com", Type: cloudflare.ZoneTypeA, Content: "1.1.1.1", TTL: 1, Tags: []string{}},
+		{ID: "b", Name: "www.example.com", Type: cloudflare.ZoneTypeCNAME, Content: "example.com", TTL: 300, Tags: []string{}},
+		{ID: "c", Name: "old.example.com", Type: cloudflare.ZoneTypeA, Content: "2.2.2.2", TTL: 1, Tags: []string{}},
+		{ID: "d", Name: "example.com", Type: cloudflare.ZoneTypeMX, Content: "mail.example.com", Priority: &mxPriority, TTL: 1, Tags: []string{}},
+	}
+	newPriority := 20
+	type args struct {
+		desired DesiredState
+		purge   bool
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Plan
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "it should have no changes when the zone matches",
+			args: args{
+				desired: DesiredState{
+					Records: []DesiredRecord{
+						{Name: "@", Type: cloudflare.ZoneTypeA, Content: "1.1.1.1"},
+						{Name: "www", Type: cloudflare.ZoneTypeCNAME, Content: "example.com", TTL: 300},
+					},
+				},
+			},
+			want:    Plan{Unmanaged: 2},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "it should plan creates, updates and purged deletes",
+			args: args{
+				desired: DesiredState{
+					Records: []DesiredRecord{
+						{Name: "example.com.", Type: cloudflare.ZoneTypeA, Content: "1.1.1.1", Proxied: true},
+						{Name: "www", Type: cloudflare.ZoneTypeCNAME, Content: "example.com", TTL: 300},
+						{Name: "new", Type: cloudflare.ZoneTypeA, Content: "3.3.3.3", Tags: []string{"env:new"}},
+						{Name: "@", Type: cloudflare.ZoneTypeMX, Content: "mail.example.com", Priority: &newPriority},
+					},
+				},
+				purge: true,
+			},
+			want: Plan{
+				Changes: []Change{
+					{
+						Action:  ActionDelete,
+						Current: &current[2],
+					},
+					{
+						Action:  ActionUpdate,
+						Current: &current[0],
+						Desired: &cloudflare.ZoneRecordRequest{ID: "a", Name: "example.com", Type: cloudflare.ZoneTypeA, Content: "1.1.1.1", TTL: 1, Proxied: true, Tags: []string{}},
+						Diff:    []string{"proxied: false -> true"},
+					},
+					{
+						Action:  ActionUpdate,
+						Current: &current[3],
+						Desired: &cloudflare.ZoneRecordRequest{ID: "d", Name: "example.com", Type: cloudflare.ZoneTypeMX, Content: "mail.example.com", Priority: &newPriority, TTL: 1, Tags: []string{}},
+						Diff:    []string{"priority: 10 -> 20"},
+					},
+					{
+						Action:  ActionCreate,
+						Desired: &cloudflare.ZoneRecordRequest{Name: "new.example.com", Type: cloudflare.ZoneTypeA, Content: "3.3.3.3", TTL: 1, Tags: []string{"env:new"}},
+					},
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "it should treat a content change as a delete and a create",
+			args: args{
+				desired: DesiredState{
+					Records: []DesiredRecord{
+						{Name: "@", Type: cloudflare.ZoneTypeA, Content: "1.1.1.1"},
+						{Name: "www", Type: cloudflare.ZoneTypeCNAME, Content: "example.com", TTL: 300},
+						{Name: "old", Type: cloudflare.ZoneTypeA, Content: "4.4.4.4"},
+					},
+				},
+				purge: true,
+			},
+			want: Plan{
+				Changes: []Change{
+					{
+						Action:  ActionDelete,
+						Current: &current[2],
+					},
+					{
+						Action:  ActionDelete,
+						Current: &current[3],
+					},
+					{
+						Action:  ActionCreate,
+						Desired: &cloudflare.ZoneRecordRequest{Name: "old.example.com", Type: cloudflare.ZoneTypeA, Content: "4.4.4.4", TTL: 1, Tags: []string{}},
+					},
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "it should reject a CNAME next to another record of the desired state",
+			args: args{
+				desired: DesiredState{
+					Records: []DesiredRecord{
+						{Name: "@", Type: cloudflare.ZoneTypeA, Content: "1.1.1.1"},
+						{Name: "www", Type: cloudflare.ZoneTypeCNAME, Content: "example.com", TTL: 300},
+						{Name: "api", Type: cloudflare.ZoneTypeCNAME, Content: "example.com"},
+						{Name: "api", Type: cloudflare.ZoneTypeTXT, Content: "hello"},
+					},
+				},
+			},
+			want: Plan{},
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, cloudflare.ErrInvalidRecord)
+			},
+		},
+		{
+			name: "it should reject duplicate records",
+			args: args{
+				desired: DesiredState{
+					Records: []DesiredRecord{
+						{Name: "www", Type: cloudflare.ZoneTypeA, Content: "1.1.1.1"},
+						{Name: "www.example.com", Type: cloudflare.ZoneTypeA, Content: "1.1.1.1"},
+					},
+				},
+			},
+			want: Plan{},
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, ErrInvalidDesiredState)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ComputePlan("example.com", tt.args.desired, current, tt.args.purge)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, len(tt.want.Changes) > 0, got.HasChanges())
+		})
+	}
+}
+
+func TestLoadDesiredState(t *testing.T) {
+	dir := t.TempDir()
+	tests := []struct {
+		name     string
+		filename string
+		content  string
+		want     DesiredState
+		wantErr  assert.ErrorAssertionFunc
+	}{
+		{
+			name:     "it should load a yaml file",
+			filename: "zone.yaml",
+			content:  "purge_unmanaged: true\nrecords:\n  - name: www\n    type: A\n    content: 1.1.1.1\n    ttl: 300\n",
+			want: DesiredState{
+				PurgeUnmanaged: true,
+				Records:        []DesiredRecord{{Name: "www", Type: cloudflare.ZoneTypeA, Content: "1.1.1.1", TTL: 300}},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:     "it should load a json file",
+			filename: "zone.json",
+			content:  `{"records": [{"name": "www", "type": "CNAME", "content": "example.com", "proxied": true}]}`,
+			want: DesiredState{
+				Records: []DesiredRecord{{Name: "www", Type: cloudflare.ZoneTypeCNAME, Content: "example.com", Proxied: true}},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:     "it should reject unknown record types",
+			filename: "invalid.yaml",
+			content:  "records:\n  - name: www\n    type: BOGUS\n    content: 1.1.1.1\n",
+			want:     DesiredState{},
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, ErrInvalidDesiredState)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(dir, tt.filename)
+			assert.NoError(t, os.WriteFile(path, []byte(tt.content), 0600))
+
+			got, err := LoadDesiredState(path)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
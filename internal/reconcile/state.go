@@ -0,0 +1,70 @@
+package reconcile
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+	"gopkg.in/yaml.v3"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var ErrInvalidDesiredState = errors.New("invalid desired state")
+
+// DesiredState is the content of a desired-state file describing every record managed in a zone.
+type DesiredState struct {
+	PurgeUnmanaged bool            `json:"purge_unmanaged" yaml:"purge_unmanaged"`
+	Records        []DesiredRecord `json:"records" yaml:"records"`
+}
+
+// DesiredRecord is a record as written in a desired-state file.
+// Names can be relative to the zone ("www", "@") or fully qualified.
+type DesiredRecord struct {
+	Name     string                    `json:"name" yaml:"name"`
+	Type     cloudflare.ZoneType       `json:"type" yaml:"type"`
+	Content  string                    `json:"content" yaml:"content"`
+	Priority *int                      `json:"priority" yaml:"priority"`
+	Data     cloudflare.ZoneRecordData `json:"data" yaml:"data"`
+	TTL      int                       `json:"ttl" yaml:"ttl"`
+	Proxied  bool                      `json:"proxied" yaml:"proxied"`
+	Tags     []string                  `json:"tags" yaml:"tags"`
+	Comment  string                    `json:"comment" yaml:"comment"`
+}
+
+// LoadDesiredState reads a desired-state file, decoding it as JSON for .json files and as YAML otherwise.
+func LoadDesiredState(path string) (DesiredState, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return DesiredState{}, err
+	}
+
+	var state DesiredState
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		err = json.Unmarshal(data, &state)
+	} else {
+		err = yaml.Unmarshal(data, &state)
+	}
+	if err != nil {
+		return DesiredState{}, fmt.Errorf("%w: %s", ErrInvalidDesiredState, err.Error())
+	}
+
+	for i, record := range state.Records {
+		if _, err := cloudflare.ParseZoneType(string(record.Type)); err != nil {
+			return DesiredState{}, fmt.Errorf("%w: record %d: %s", ErrInvalidDesiredState, i+1, err.Error())
+		}
+		if record.Type.RequiresData() {
+			if len(record.Data) == 0 {
+				return DesiredState{}, fmt.Errorf("%w: record %d: data is required for %s records", ErrInvalidDesiredState, i+1, record.Type)
+			}
+		} else if record.Content == "" {
+			return DesiredState{}, fmt.Errorf("%w: record %d: content is required", ErrInvalidDesiredState, i+1)
+		}
+		if record.TTL != 0 && (record.TTL < 1 || record.TTL > 86400) {
+			return DesiredState{}, fmt.Errorf("%w: record %d: TTL must be between 1 and 86400", ErrInvalidDesiredState, i+1)
+		}
+	}
+
+	return state, nil
+}
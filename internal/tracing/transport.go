@@ -0,0 +1,142 @@
+package tracing
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const redacted = "[REDACTED]"
+
+// Transport is an http.RoundTripper logging every request and response it sends: method, URL, status, timing,
+// headers and optionally bodies. Credential headers are always redacted, as are the secrets it is given wherever
+// they appear.
+type Transport struct {
+	next    http.RoundTripper
+	out     io.Writer
+	bodies  bool
+	secrets []string
+	now     func() time.Time
+	mu      sync.Mutex
+}
+
+type Option func(*Transport)
+
+// WithBodies also logs the request and response bodies.
+func WithBodies() Option {
+	return func(t *Transport) {
+		t.bodies = true
+	}
+}
+
+// WithSecrets redacts the values from URLs, headers and bodies.
+func WithSecrets(secrets ...string) Option {
+	return func(t *Transport) {
+		for _, secret := range secrets {
+			if secret != "" {
+				t.secrets = append(t.secrets, secret)
+			}
+		}
+	}
+}
+
+func NewTransport(next http.RoundTripper, out io.Writer, options ...Option) *Transport {
+	t := &Transport{
+		next: next,
+		out:  out,
+		now:  time.Now,
+	}
+	for _, option := range options {
+		option(t)
+	}
+	return t
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var requestBody []byte
+	if t.bodies && req.Body != nil && req.Body != http.NoBody {
+		var err error
+		requestBody, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(requestBody))
+	}
+
+	var entry strings.Builder
+	start := t.now()
+	fmt.Fprintf(&entry, "%s --> %s %s\n", start.Format(time.RFC3339), req.Method, req.URL)
+	t.writeHeaders(&entry, req.Header)
+	t.writeBody(&entry, requestBody)
+
+	resp, err := t.next.RoundTrip(req)
+	elapsed := t.now().Sub(start).Round(time.Millisecond)
+	if err != nil {
+		fmt.Fprintf(&entry, "<-- error %s %s (%s): %s\n", req.Method, req.URL, elapsed, err)
+		t.write(entry.String())
+		return nil, err
+	}
+
+	fmt.Fprintf(&entry, "<-- %s %s %s (%s)\n", resp.Status, req.Method, req.URL, elapsed)
+	t.writeHeaders(&entry, resp.Header)
+	if t.bodies && resp.Body != nil {
+		responseBody, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			t.write(entry.String())
+			return nil, err
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+		t.writeBody(&entry, responseBody)
+	}
+
+	t.write(entry.String())
+	return resp, nil
+}
+
+func (t *Transport) writeHeaders(entry *strings.Builder, header http.Header) {
+	keys := make([]string, 0, len(header))
+	for key := range header {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		value := strings.Join(header[key], ", ")
+		if sensitiveHeader(key) {
+			value = redacted
+		}
+		fmt.Fprintf(entry, "    %s: %s\n", key, value)
+	}
+}
+
+func (t *Transport) writeBody(entry *strings.Builder, body []byte) {
+	if len(body) == 0 {
+		return
+	}
+	fmt.Fprintf(entry, "    %s\n", strings.TrimSpace(string(body)))
+}
+
+// write redacts the secrets of a whole entry and writes it at once, so entries of concurrent requests do not interleave.
+func (t *Transport) write(entry string) {
+	for _, secret := range t.secrets {
+		entry = strings.ReplaceAll(entry, secret, redacted)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	_, _ = io.WriteString(t.out, entry)
+}
+
+// sensitiveHeader reports whether the header carries credentials: Authorization and the X-Auth-* headers
+// of the Global API Key and Origin CA key.
+func sensitiveHeader(key string) bool {
+	key = http.CanonicalHeaderKey(key)
+	return key == "Authorization" || key == "Cookie" || key == "Set-Cookie" || strings.HasPrefix(key, "X-Auth-")
+}
@@ -0,0 +1,102 @@
+package tracing
+
+import (
+	"bytes"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTracedClient(out io.Writer, options ...Option) *http.Client {
+	transport := NewTransport(http.DefaultTransport, out, options...)
+	transport.now = func() time.Time { return time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC) }
+	return &http.Client{Transport: transport}
+}
+
+func TestTransport_RoundTrip(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"echo":` + string(body) + `}`))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name        string
+		options     []Option
+		wantLogged  []string
+		wantMissing []string
+	}{
+		{
+			name:    "it should log the request and response without bodies",
+			options: []Option{WithSecrets("global-key")},
+			wantLogged: []string{
+				"2024-01-01T00:00:00Z --> POST " + server.URL + "/zones",
+				"<-- 201 Created POST " + server.URL + "/zones (0s)",
+				"Authorization: [REDACTED]",
+				"X-Auth-Key: [REDACTED]",
+				"X-Auth-Email: [REDACTED]",
+				"Content-Type: application/json",
+			},
+			wantMissing: []string{"api-token", "global-key", `"name"`},
+		},
+		{
+			name:    "it should log bodies redacting the secrets",
+			options: []Option{WithBodies(), WithSecrets("api-token", "global-key", "")},
+			wantLogged: []string{
+				`{"name":"example.com","key":"[REDACTED]"}`,
+				`{"echo":{"name":"example.com","key":"[REDACTED]"}}`,
+			},
+			wantMissing: []string{"api-token", "global-key"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			client := newTracedClient(&out, tt.options...)
+
+			req, err := http.NewRequest(http.MethodPost, server.URL+"/zones", strings.NewReader(`{"name":"example.com","key":"global-key"}`))
+			assert.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer api-token")
+			req.Header.Set("X-Auth-Key", "global-key")
+			req.Header.Set("X-Auth-Email", "user@example.com")
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := client.Do(req)
+			assert.NoError(t, err)
+			body, err := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			assert.NoError(t, err)
+			assert.Equal(t, `{"echo":{"name":"example.com","key":"global-key"}}`, string(body), "the response should reach the caller untouched")
+
+			for _, want := range tt.wantLogged {
+				assert.Contains(t, out.String(), want)
+			}
+			for _, missing := range tt.wantMissing {
+				assert.NotContains(t, out.String(), missing)
+			}
+		})
+	}
+}
+
+type failingTransport struct{}
+
+func (failingTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	return nil, errors.New("connection refused")
+}
+
+func TestTransport_RoundTripError(t *testing.T) {
+	var out bytes.Buffer
+	client := &http.Client{Transport: NewTransport(failingTransport{}, &out)}
+
+	_, err := client.Get("https://api.cloudflare.com/client/v4/zones")
+	assert.Error(t, err)
+	assert.Contains(t, out.String(), "<-- error GET https://api.cloudflare.com/client/v4/zones")
+	assert.Contains(t, out.String(), "connection refused")
+}
@@ -0,0 +1,166 @@
+package zonecache
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// DefaultTTL is how long a resolved zone ID is trusted before it is looked up again.
+const DefaultTTL = 24 * time.Hour
+
+type entry struct {
+	ZoneID    string    `json:"zone_id"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// entries maps a profile to the zone IDs resolved with its credentials, keyed by domain.
+type entries map[string]map[string]entry
+
+// Cache is an on-disk map of domains to zone IDs, scoped to a profile since each one may reach different accounts.
+// It is shared by every process, so it is re-read on each access and replaced atomically on each write.
+type Cache struct {
+	path    string
+	profile string
+	ttl     time.Duration
+	now     func() time.Time
+	mu      sync.Mutex
+}
+
+func NewCache(path, profile string, ttl time.Duration) *Cache {
+	return &Cache{
+		path:    path,
+		profile: profile,
+		ttl:     ttl,
+		now:     time.Now,
+	}
+}
+
+// Get returns the zone ID cached for the domain, unless it is missing or expired.
+func (c *Cache) Get(domain string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cached, err := c.load()
+	if err != nil {
+		return "", false
+	}
+	found, ok := cached[c.profile][domain]
+	if !ok || !c.now().Before(found.ExpiresAt) {
+		return "", false
+	}
+	return found.ZoneID, true
+}
+
+// Set caches the zone ID of the domain for the TTL of the cache.
+func (c *Cache) Set(domain, zoneID string) error {
+	return c.update(func(profileEntries map[string]entry) {
+		profileEntries[domain] = entry{
+			ZoneID:    zoneID,
+			ExpiresAt: c.now().Add(c.ttl),
+		}
+	})
+}
+
+// Invalidate forgets the domain.
+func (c *Cache) Invalidate(domain string) error {
+	return c.update(func(profileEntries map[string]entry) {
+		delete(profileEntries, domain)
+	})
+}
+
+// InvalidateZoneID forgets every domain resolved to the zone ID.
+func (c *Cache) InvalidateZoneID(zoneID string) error {
+	return c.update(func(profileEntries map[string]entry) {
+		for domain, cached := range profileEntries {
+			if cached.ZoneID == zoneID {
+				delete(profileEntries, domain)
+			}
+		}
+	})
+}
+
+// Clear removes the cache of every profile.
+func (c *Cache) Clear() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := os.Remove(c.path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (c *Cache) update(change func(map[string]entry)) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cached, err := c.load()
+	if err != nil {
+		// A corrupted cache is discarded rather than blocking every command.
+		cached = entries{}
+	}
+	if cached[c.profile] == nil {
+		cached[c.profile] = map[string]entry{}
+	}
+	change(cached[c.profile])
+
+	// Expired entries are dropped so the file does not grow with every domain ever used.
+	now := c.now()
+	for profile, profileEntries := range cached {
+		for domain, cached := range profileEntries {
+			if !now.Before(cached.ExpiresAt) {
+				delete(profileEntries, domain)
+			}
+		}
+		if len(profileEntries) == 0 {
+			delete(cached, profile)
+		}
+	}
+
+	return c.save(cached)
+}
+
+func (c *Cache) load() (entries, error) {
+	data, err := os.ReadFile(c.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return entries{}, nil
+		}
+		return nil, err
+	}
+
+	cached := entries{}
+	if err := json.Unmarshal(data, &cached); err != nil {
+		return nil, err
+	}
+	return cached, nil
+}
+
+func (c *Cache) save(cached entries) error {
+	if err := os.MkdirAll(filepath.Dir(c.path), 0700); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(cached)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(c.path), filepath.Base(c.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), c.path)
+}
@@ -0,0 +1,76 @@
+package zonecache
+
+import (
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestCache(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache", "zones.json")
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	cache := NewCache(path, "default", time.Hour)
+	cache.now = func() time.Time { return now }
+
+	_, ok := cache.Get("example.com")
+	assert.False(t, ok)
+
+	assert.NoError(t, cache.Set("example.com", "zone-id"))
+	zoneID, ok := cache.Get("example.com")
+	assert.True(t, ok)
+	assert.Equal(t, "zone-id", zoneID)
+
+	other := NewCache(path, "staging", time.Hour)
+	other.now = cache.now
+	_, ok = other.Get("example.com")
+	assert.False(t, ok, "entries should be scoped to the profile")
+
+	now = now.Add(time.Hour)
+	_, ok = cache.Get("example.com")
+	assert.False(t, ok, "entries should expire after the TTL")
+}
+
+func TestCache_Invalidate(t *testing.T) {
+	cache := NewCache(filepath.Join(t.TempDir(), "zones.json"), "default", time.Hour)
+	assert.NoError(t, cache.Set("example.com", "zone-id"))
+	assert.NoError(t, cache.Set("www.example.com", "zone-id"))
+	assert.NoError(t, cache.Set("example.org", "other-zone-id"))
+
+	assert.NoError(t, cache.InvalidateZoneID("zone-id"))
+	_, ok := cache.Get("example.com")
+	assert.False(t, ok)
+	_, ok = cache.Get("www.example.com")
+	assert.False(t, ok)
+
+	_, ok = cache.Get("example.org")
+	assert.True(t, ok)
+	assert.NoError(t, cache.Invalidate("example.org"))
+	_, ok = cache.Get("example.org")
+	assert.False(t, ok)
+}
+
+func TestCache_Clear(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "zones.json")
+	cache := NewCache(path, "default", time.Hour)
+	assert.NoError(t, cache.Clear(), "clearing a missing cache should succeed")
+
+	assert.NoError(t, cache.Set("example.com", "zone-id"))
+	assert.NoError(t, cache.Clear())
+	_, err := os.Stat(path)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestCache_Corrupted(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "zones.json")
+	assert.NoError(t, os.WriteFile(path, []byte("not json"), 0600))
+	cache := NewCache(path, "default", time.Hour)
+
+	_, ok := cache.Get("example.com")
+	assert.False(t, ok)
+	assert.NoError(t, cache.Set("example.com", "zone-id"))
+	zoneID, ok := cache.Get("example.com")
+	assert.True(t, ok)
+	assert.Equal(t, "zone-id", zoneID)
+}
@@ -0,0 +1,142 @@
+package zonecache
+
+import (
+	"context"
+	"errors"
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+)
+
+// cachedClient resolves zone IDs from the cache before asking the API, and forgets a cached zone as soon as
+// the API reports it missing. The remaining methods are served by the embedded client.
+type cachedClient struct {
+	cloudflare.CloudflareClient
+	cache *Cache
+}
+
+func NewCachedClient(client cloudflare.CloudflareClient, cache *Cache) cloudflare.CloudflareClient {
+	return &cachedClient{
+		CloudflareClient: client,
+		cache:            cache,
+	}
+}
+
+func (c *cachedClient) GetZoneByDomain(ctx context.Context, request cloudflare.GetZoneByDomainRequest) (*cloudflare.GetZoneByDomainResponse, error) {
+	if zoneID, ok := c.cache.Get(request.Domain); ok {
+		return &cloudflare.GetZoneByDomainResponse{ZoneID: zoneID}, nil
+	}
+
+	response, err := c.CloudflareClient.GetZoneByDomain(ctx, request)
+	if err != nil {
+		if errors.Is(err, cloudflare.ErrZoneNotFound) {
+			_ = c.cache.Invalidate(request.Domain)
+		}
+		return nil, err
+	}
+
+	// Failing to write the cache only costs a lookup on the next command.
+	_ = c.cache.Set(request.Domain, response.ZoneID)
+	return response, nil
+}
+
+func (c *cachedClient) GetZone(ctx context.Context, request cloudflare.GetZoneRequest) (*cloudflare.GetZoneResponse, error) {
+	response, err := c.CloudflareClient.GetZone(ctx, request)
+	c.invalidateOnNotFound(request.ZoneID, err)
+	return response, err
+}
+
+// CreateZone caches the zone it creates, it is usually managed right after.
+func (c *cachedClient) CreateZone(ctx context.Context, request cloudflare.CreateZoneRequest) (*cloudflare.CreateZoneResponse, error) {
+	response, err := c.CloudflareClient.CreateZone(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	_ = c.cache.Set(response.Zone.Name, response.Zone.ID)
+	return response, nil
+}
+
+func (c *cachedClient) PatchZone(ctx context.Context, request cloudflare.PatchZoneRequest) (*cloudflare.PatchZoneResponse, error) {
+	response, err := c.CloudflareClient.PatchZone(ctx, request)
+	c.invalidateOnNotFound(request.ZoneID, err)
+	return response, err
+}
+
+// DeleteZone forgets the zone once it is deleted, a zone added again for the same domain gets a new ID.
+func (c *cachedClient) DeleteZone(ctx context.Context, request cloudflare.DeleteZoneRequest) error {
+	err := c.CloudflareClient.DeleteZone(ctx, request)
+	if err == nil || errors.Is(err, cloudflare.ErrZoneNotFound) {
+		_ = c.cache.InvalidateZoneID(request.ZoneID)
+	}
+	return err
+}
+
+func (c *cachedClient) ZoneActivationCheck(ctx context.Context, request cloudflare.ZoneActivationCheckRequest) error {
+	err := c.CloudflareClient.ZoneActivationCheck(ctx, request)
+	c.invalidateOnNotFound(request.ZoneID, err)
+	return err
+}
+
+func (c *cachedClient) ListZoneSettings(ctx context.Context, request cloudflare.ListZoneSettingsRequest) (*cloudflare.ListZoneSettingsResponse, error) {
+	response, err := c.CloudflareClient.ListZoneSettings(ctx, request)
+	c.invalidateOnNotFound(request.ZoneID, err)
+	return response, err
+}
+
+func (c *cachedClient) PurgeCache(ctx context.Context, request cloudflare.PurgeCacheRequest) (*cloudflare.PurgeCacheResponse, error) {
+	response, err := c.CloudflareClient.PurgeCache(ctx, request)
+	c.invalidateOnNotFound(request.ZoneID, err)
+	return response, err
+}
+
+func (c *cachedClient) GetZoneRecords(ctx context.Context, request cloudflare.GetZoneRecordsRequest) (*cloudflare.GetZoneRecordsResponse, error) {
+	response, err := c.CloudflareClient.GetZoneRecords(ctx, request)
+	c.invalidateOnNotFound(request.ZoneID, err)
+	return response, err
+}
+
+func (c *cachedClient) GetZoneRecord(ctx context.Context, request cloudflare.GetZoneRecordRequest) (*cloudflare.GetZoneRecordResponse, error) {
+	response, err := c.CloudflareClient.GetZoneRecord(ctx, request)
+	c.invalidateOnRecordNotFound(request.ZoneID, err)
+	return response, err
+}
+
+func (c *cachedClient) AddZoneRecord(ctx context.Context, request cloudflare.AddZoneRecordRequest) (*cloudflare.AddZoneRecordResponse, error) {
+	response, err := c.CloudflareClient.AddZoneRecord(ctx, request)
+	c.invalidateOnNotFound(request.ZoneID, err)
+	return response, err
+}
+
+func (c *cachedClient) UpdateZoneRecord(ctx context.Context, request cloudflare.UpdateZoneRecordRequest) (*cloudflare.UpdateZoneRecordResponse, error) {
+	response, err := c.CloudflareClient.UpdateZoneRecord(ctx, request)
+	c.invalidateOnRecordNotFound(request.ZoneID, err)
+	return response, err
+}
+
+func (c *cachedClient) PatchZoneRecord(ctx context.Context, request cloudflare.PatchZoneRecordRequest) (*cloudflare.PatchZoneRecordResponse, error) {
+	response, err := c.CloudflareClient.PatchZoneRecord(ctx, request)
+	c.invalidateOnRecordNotFound(request.ZoneID, err)
+	return response, err
+}
+
+func (c *cachedClient) DeleteZoneRecord(ctx context.Context, request cloudflare.DeleteZoneRecordRequest) error {
+	err := c.CloudflareClient.DeleteZoneRecord(ctx, request)
+	c.invalidateOnRecordNotFound(request.ZoneID, err)
+	return err
+}
+
+// invalidateOnNotFound forgets the zone when the API no longer knows it, e.g. after it was deleted and re-added,
+// so the next command looks it up again.
+func (c *cachedClient) invalidateOnNotFound(zoneID string, err error) {
+	if errors.Is(err, cloudflare.ErrZoneNotFound) {
+		_ = c.cache.InvalidateZoneID(zoneID)
+	}
+}
+
+// invalidateOnRecordNotFound is invalidateOnNotFound for the endpoints of a single record, whose 404 does not tell
+// a missing record from a missing zone. Forgetting a zone that still exists only costs a lookup on the next command.
+func (c *cachedClient) invalidateOnRecordNotFound(zoneID string, err error) {
+	if errors.Is(err, cloudflare.ErrRecordNotFound) {
+		err = cloudflare.ErrZoneNotFound
+	}
+	c.invalidateOnNotFound(zoneID, err)
+}
@@ -0,0 +1,182 @@
+package zonecache
+
+import (
+	"context"
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+	"github.com/stretchr/testify/assert"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+type fakeClient struct {
+	cloudflare.CloudflareClient
+	zones   map[string]string
+	lookups int
+}
+
+func (f *fakeClient) GetZoneByDomain(_ context.Context, request cloudflare.GetZoneByDomainRequest) (*cloudflare.GetZoneByDomainResponse, error) {
+	f.lookups++
+	zoneID, ok := f.zones[request.Domain]
+	if !ok {
+		return nil, cloudflare.ErrZoneNotFound
+	}
+	return &cloudflare.GetZoneByDomainResponse{ZoneID: zoneID}, nil
+}
+
+func (f *fakeClient) GetZoneRecords(_ context.Context, request cloudflare.GetZoneRecordsRequest) (*cloudflare.GetZoneRecordsResponse, error) {
+	for _, zoneID := range f.zones {
+		if zoneID == request.ZoneID {
+			return &cloudflare.GetZoneRecordsResponse{}, nil
+		}
+	}
+	return nil, cloudflare.ErrZoneNotFound
+}
+
+func TestCachedClient_GetZoneByDomain(t *testing.T) {
+	fake := &fakeClient{zones: map[string]string{"example.com": "zone-id"}}
+	client := NewCachedClient(fake, NewCache(filepath.Join(t.TempDir(), "zones.json"), "default", time.Hour))
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		zone, err := client.GetZoneByDomain(ctx, cloudflare.GetZoneByDomainRequest{Domain: "example.com"})
+		assert.NoError(t, err)
+		assert.Equal(t, "zone-id", zone.ZoneID)
+	}
+	assert.Equal(t, 1, fake.lookups, "the zone should be looked up once")
+
+	_, err := client.GetZoneByDomain(ctx, cloudflare.GetZoneByDomainRequest{Domain: "missing.com"})
+	assert.ErrorIs(t, err, cloudflare.ErrZoneNotFound)
+}
+
+func TestCachedClient_InvalidateOnNotFound(t *testing.T) {
+	fake := &fakeClient{zones: map[string]string{"example.com": "zone-id"}}
+	client := NewCachedClient(fake, NewCache(filepath.Join(t.TempDir(), "zones.json"), "default", time.Hour))
+	ctx := context.Background()
+
+	_, err := client.GetZoneByDomain(ctx, cloudflare.GetZoneByDomainRequest{Domain: "example.com"})
+	assert.NoError(t, err)
+
+	// The zone was deleted and added again, so it has a new ID.
+	fake.zones["example.com"] = "new-zone-id"
+	_, err = client.GetZoneRecords(ctx, cloudflare.GetZoneRecordsRequest{ZoneID: "zone-id"})
+	assert.ErrorIs(t, err, cloudflare.ErrZoneNotFound)
+
+	zone, err := client.GetZoneByDomain(ctx, cloudflare.GetZoneByDomainRequest{Domain: "example.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, "new-zone-id", zone.ZoneID)
+	assert.Equal(t, 2, fake.lookups)
+}
+
+func (f *fakeClient) CreateZone(_ context.Context, request cloudflare.CreateZoneRequest) (*cloudflare.CreateZoneResponse, error) {
+	f.zones[request.Name] = "created-zone-id"
+	return &cloudflare.CreateZoneResponse{Zone: cloudflare.Zone{ID: "created-zone-id", Name: request.Name}}, nil
+}
+
+func (f *fakeClient) DeleteZone(_ context.Context, request cloudflare.DeleteZoneRequest) error {
+	for domain, zoneID := range f.zones {
+		if zoneID == request.ZoneID {
+			delete(f.zones, domain)
+		}
+	}
+	return nil
+}
+
+func TestCachedClient_ZoneLifecycle(t *testing.T) {
+	fake := &fakeClient{zones: map[string]string{}}
+	client := NewCachedClient(fake, NewCache(filepath.Join(t.TempDir(), "zones.json"), "default", time.Hour))
+	ctx := context.Background()
+
+	_, err := client.CreateZone(ctx, cloudflare.CreateZoneRequest{Name: "example.com"})
+	assert.NoError(t, err)
+	zone, err := client.GetZoneByDomain(ctx, cloudflare.GetZoneByDomainRequest{Domain: "example.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, "created-zone-id", zone.ZoneID)
+	assert.Equal(t, 0, fake.lookups, "a created zone should be cached")
+
+	assert.NoError(t, client.DeleteZone(ctx, cloudflare.DeleteZoneRequest{ZoneID: "created-zone-id"}))
+	_, err = client.GetZoneByDomain(ctx, cloudflare.GetZoneByDomainRequest{Domain: "example.com"})
+	assert.ErrorIs(t, err, cloudflare.ErrZoneNotFound)
+	assert.Equal(t, 1, fake.lookups, "a deleted zone should be looked up again")
+}
+
+// recordNotFound answers like the record endpoints of the API, which report a missing zone as a missing record.
+func (f *fakeClient) recordNotFound(zoneID string) error {
+	for _, known := range f.zones {
+		if known == zoneID {
+			return nil
+		}
+	}
+	return cloudflare.ErrRecordNotFound
+}
+
+func (f *fakeClient) GetZoneRecord(_ context.Context, request cloudflare.GetZoneRecordRequest) (*cloudflare.GetZoneRecordResponse, error) {
+	return &cloudflare.GetZoneRecordResponse{}, f.recordNotFound(request.ZoneID)
+}
+
+func (f *fakeClient) UpdateZoneRecord(_ context.Context, request cloudflare.UpdateZoneRecordRequest) (*cloudflare.UpdateZoneRecordResponse, error) {
+	return &cloudflare.UpdateZoneRecordResponse{}, f.recordNotFound(request.ZoneID)
+}
+
+func (f *fakeClient) PatchZoneRecord(_ context.Context, request cloudflare.PatchZoneRecordRequest) (*cloudflare.PatchZoneRecordResponse, error) {
+	return &cloudflare.PatchZoneRecordResponse{}, f.recordNotFound(request.ZoneID)
+}
+
+func (f *fakeClient) DeleteZoneRecord(_ context.Context, request cloudflare.DeleteZoneRecordRequest) error {
+	return f.recordNotFound(request.ZoneID)
+}
+
+func TestCachedClient_InvalidateOnRecordNotFound(t *testing.T) {
+	tests := []struct {
+		name string
+		call func(client cloudflare.CloudflareClient, zoneID string) error
+	}{
+		{
+			name: "it should invalidate the zone when getting a record",
+			call: func(client cloudflare.CloudflareClient, zoneID string) error {
+				_, err := client.GetZoneRecord(context.Background(), cloudflare.GetZoneRecordRequest{ZoneID: zoneID, RecordID: "record-id"})
+				return err
+			},
+		},
+		{
+			name: "it should invalidate the zone when updating a record",
+			call: func(client cloudflare.CloudflareClient, zoneID string) error {
+				_, err := client.UpdateZoneRecord(context.Background(), cloudflare.UpdateZoneRecordRequest{ZoneID: zoneID, RecordID: "record-id"})
+				return err
+			},
+		},
+		{
+			name: "it should invalidate the zone when patching a record",
+			call: func(client cloudflare.CloudflareClient, zoneID string) error {
+				_, err := client.PatchZoneRecord(context.Background(), cloudflare.PatchZoneRecordRequest{ZoneID: zoneID, RecordID: "record-id"})
+				return err
+			},
+		},
+		{
+			name: "it should invalidate the zone when deleting a record",
+			call: func(client cloudflare.CloudflareClient, zoneID string) error {
+				return client.DeleteZoneRecord(context.Background(), cloudflare.DeleteZoneRecordRequest{ZoneID: zoneID, RecordID: "record-id"})
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeClient{zones: map[string]string{"example.com": "zone-id"}}
+			client := NewCachedClient(fake, NewCache(filepath.Join(t.TempDir(), "zones.json"), "default", time.Hour))
+			ctx := context.Background()
+
+			_, err := client.GetZoneByDomain(ctx, cloudflare.GetZoneByDomainRequest{Domain: "example.com"})
+			assert.NoError(t, err)
+			assert.NoError(t, tt.call(client, "zone-id"), "a known zone should be kept")
+
+			// The zone was deleted and added again, so it has a new ID.
+			fake.zones["example.com"] = "new-zone-id"
+			assert.ErrorIs(t, tt.call(client, "zone-id"), cloudflare.ErrRecordNotFound)
+
+			zone, err := client.GetZoneByDomain(ctx, cloudflare.GetZoneByDomainRequest{Domain: "example.com"})
+			assert.NoError(t, err)
+			assert.Equal(t, "new-zone-id", zone.ZoneID)
+			assert.Equal(t, 2, fake.lookups)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package zonefile
+
+import (
+	"errors"
+	"fmt"
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrUnsupportedRecord = errors.New("unsupported record")
+	// hostnameDataFields is the position of the data field holding a domain name, which may be relative to the origin.
+	hostnameDataFields = map[cloudflare.ZoneType]int{
+		cloudflare.ZoneTypeSRV:   3,
+		cloudflare.ZoneTypeHTTPS: 1,
+		cloudflare.ZoneTypeSVCB:  1,
+		cloudflare.ZoneTypeNAPTR: 5,
+	}
+)
+
+// ZoneRecordRequest converts a zone file record into the request used to create it through the Cloudflare API.
+// Hostnames in the data are expanded against the record origin and TTLs of 0 become Cloudflare's automatic TTL.
+func (r Record) ZoneRecordRequest() (cloudflare.ZoneRecordRequest, error) {
+	zoneType, err := cloudflare.ParseZoneType(r.Type)
+	if err != nil {
+		return cloudflare.ZoneRecordRequest{}, fmt.Errorf("%w: %s", ErrUnsupportedRecord, err.Error())
+	}
+	if r.Class != "IN" {
+		return cloudflare.ZoneRecordRequest{}, fmt.Errorf("%w: class %s", ErrUnsupportedRecord, r.Class)
+	}
+
+	request := cloudflare.ZoneRecordRequest{
+		Type: zoneType,
+		Name: r.Name,
+		TTL:  r.TTL,
+		Tags: []string{},
+	}
+	if request.TTL == 0 {
+		request.TTL = 1
+	}
+
+	switch zoneType {
+	case cloudflare.ZoneTypeCNAME, cloudflare.ZoneTypeNS:
+		if err := r.expectFields(1); err != nil {
+			return cloudflare.ZoneRecordRequest{}, err
+		}
+		request.Content = ExpandName(r.RData[0], r.Origin)
+	case cloudflare.ZoneTypeMX:
+		if err := r.expectFields(2); err != nil {
+			return cloudflare.ZoneRecordRequest{}, err
+		}
+		priority, err := r.priority()
+		if err != nil {
+			return cloudflare.ZoneRecordRequest{}, err
+		}
+		request.Priority = &priority
+		request.Content = ExpandName(r.RData[1], r.Origin)
+	case cloudflare.ZoneTypePTR:
+		if err := r.expectFields(1); err != nil {
+			return cloudflare.ZoneRecordRequest{}, err
+		}
+		request.Content = ExpandName(r.RData[0], r.Origin)
+	case cloudflare.ZoneTypeURI:
+		if err := r.expectFields(3); err != nil {
+			return cloudflare.ZoneRecordRequest{}, err
+		}
+		priority, err := r.priority()
+		if err != nil {
+			return cloudflare.ZoneRecordRequest{}, err
+		}
+		request.Priority = &priority
+		request.Data, err = cloudflare.ZoneRecordDataFromFields(zoneType, r.dataFields(1))
+		if err != nil {
+			return cloudflare.ZoneRecordRequest{}, err
+		}
+	case cloudflare.ZoneTypeTXT:
+		request.Content = r.txtContent()
+	default:
+		if !zoneType.RequiresData() {
+			request.Content = strings.Join(r.RData, " ")
+			break
+		}
+
+		fields := r.dataFields(0)
+		if index, ok := hostnameDataFields[zoneType]; ok && index < len(fields) && fields[index] != "." {
+			fields[index] = ExpandName(fields[index], r.Origin)
+		}
+		request.Data, err = cloudflare.ZoneRecordDataFromFields(zoneType, fields)
+		if err != nil {
+			return cloudflare.ZoneRecordRequest{}, err
+		}
+	}
+
+	return request, nil
+}
+
+func (r Record) expectFields(n int) error {
+	if len(r.RData) != n {
+		return fmt.Errorf("%w: %s record %s expects %d data fields, got %d", ErrSyntax, r.Type, r.Name, n, len(r.RData))
+	}
+	return nil
+}
+
+func (r Record) priority() (int, error) {
+	priority, err := strconv.Atoi(r.RData[0])
+	if err != nil || priority < 0 || priority > 65535 {
+		return 0, fmt.Errorf("%w: invalid priority %q for %s %s", ErrSyntax, r.RData[0], r.Name, r.Type)
+	}
+	return priority, nil
+}
+
+// dataFields returns the decoded data fields starting at offset.
+func (r Record) dataFields(offset int) []string {
+	fields := make([]string, 0, len(r.RData)-offset)
+	for _, field := range r.RData[offset:] {
+		fields = append(fields, DecodeCharacterString(field))
+	}
+	return fields
+}
+
+// txtContent keeps a single character-string as plain text and re-quotes multiple strings so their boundaries survive.
+func (r Record) txtContent() string {
+	if len(r.RData) == 1 {
+		return DecodeCharacterString(r.RData[0])
+	}
+
+	chunks := make([]string, len(r.RData))
+	for i, field := range r.RData {
+		chunks[i] = quoteCharacterString(DecodeCharacterString(field))
+	}
+	return strings.Join(chunks, " ")
+}
@@ -0,0 +1,408 @@
+package zonefile
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// maxIncludeDepth bounds nested $INCLUDE directives so include cycles fail instead of recursing forever.
+const maxIncludeDepth = 10
+
+var (
+	ErrSyntax         = errors.New("zone file syntax error")
+	ErrIncludeTooDeep = errors.New("too many nested $INCLUDE directives")
+	classes           = map[string]bool{"IN": true, "CH": true, "HS": true, "CS": true}
+)
+
+// Record is a resource record read from a zone file.
+// Name is fully qualified without the trailing dot and RData keeps each field in presentation format,
+// so quoted character-strings still carry their quotes and escapes.
+type Record struct {
+	Name   string
+	TTL    int
+	Class  string
+	Type   string
+	RData  []string
+	Origin string
+	File   string
+	Line   int
+}
+
+type parser struct {
+	origin     string
+	defaultTTL int
+	lastTTL    int
+	lastOwner  string
+	file       string
+	depth      int
+}
+
+// ParseFile parses the zone file at path. Relative names are resolved against origin until a $ORIGIN directive changes it,
+// and $INCLUDE paths are resolved relative to the including file.
+func ParseFile(path, origin string) ([]Record, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	p := &parser{origin: normalizeName(origin), file: path}
+	return p.parse(file)
+}
+
+// Parse parses a zone file read from r. $INCLUDE paths are resolved relative to the working directory.
+func Parse(r io.Reader, origin string) ([]Record, error) {
+	p := &parser{origin: normalizeName(origin)}
+	return p.parse(r)
+}
+
+func (p *parser) parse(r io.Reader) ([]Record, error) {
+	lines, err := tokenize(r)
+	if err != nil {
+		return nil, p.wrap(0, err)
+	}
+
+	var records []Record
+	for _, line := range lines {
+		switch strings.ToUpper(line.tokens[0]) {
+		case "$ORIGIN":
+			if len(line.tokens) != 2 {
+				return nil, p.wrap(line.number, fmt.Errorf("$ORIGIN expects exactly one name"))
+			}
+			p.origin = p.absolute(line.tokens[1])
+		case "$TTL":
+			if len(line.tokens) != 2 {
+				return nil, p.wrap(line.number, fmt.Errorf("$TTL expects exactly one value"))
+			}
+			ttl, err := parseTTL(line.tokens[1])
+			if err != nil {
+				return nil, p.wrap(line.number, err)
+			}
+			p.defaultTTL = ttl
+		case "$INCLUDE":
+			included, err := p.include(line)
+			if err != nil {
+				return nil, err
+			}
+			records = append(records, included...)
+		default:
+			record, err := p.record(line)
+			if err != nil {
+				return nil, p.wrap(line.number, err)
+			}
+			records = append(records, record)
+		}
+	}
+
+	return records, nil
+}
+
+func (p *parser) include(line logicalLine) ([]Record, error) {
+	if len(line.tokens) < 2 || len(line.tokens) > 3 {
+		return nil, p.wrap(line.number, fmt.Errorf("$INCLUDE expects a file name and an optional origin"))
+	}
+	if p.depth >= maxIncludeDepth {
+		return nil, p.wrap(line.number, ErrIncludeTooDeep)
+	}
+
+	path := line.tokens[1]
+	if !filepath.IsAbs(path) && p.file != "" {
+		path = filepath.Join(filepath.Dir(p.file), path)
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, p.wrap(line.number, err)
+	}
+	defer file.Close()
+
+	// The included file gets its own copy of the parser state, so an $ORIGIN inside it does not leak back.
+	child := &parser{
+		origin:     p.origin,
+		defaultTTL: p.defaultTTL,
+		lastTTL:    p.lastTTL,
+		lastOwner:  p.lastOwner,
+		file:       path,
+		depth:      p.depth + 1,
+	}
+	if len(line.tokens) == 3 {
+		child.origin = p.absolute(line.tokens[2])
+	}
+
+	return child.parse(file)
+}
+
+func (p *parser) record(line logicalLine) (Record, error) {
+	tokens := line.tokens
+	record := Record{Class: "IN", Origin: p.origin, File: p.file, Line: line.number}
+
+	if line.blankOwner {
+		if p.lastOwner == "" {
+			return Record{}, fmt.Errorf("record without owner name")
+		}
+		record.Name = p.lastOwner
+	} else {
+		record.Name = p.absolute(tokens[0])
+		tokens = tokens[1:]
+	}
+
+	ttl, hasTTL := 0, false
+	for i := 0; i < 2 && len(tokens) > 0; i++ {
+		if classes[strings.ToUpper(tokens[0])] {
+			record.Class = strings.ToUpper(tokens[0])
+			tokens = tokens[1:]
+			continue
+		}
+		if value, err := parseTTL(tokens[0]); err == nil && !hasTTL {
+			ttl, hasTTL = value, true
+			tokens = tokens[1:]
+		}
+	}
+
+	if len(tokens) == 0 {
+		return Record{}, fmt.Errorf("missing record type for %s", record.Name)
+	}
+	record.Type = strings.ToUpper(tokens[0])
+	record.RData = tokens[1:]
+	if len(record.RData) == 0 {
+		return Record{}, fmt.Errorf("missing data for %s %s", record.Name, record.Type)
+	}
+
+	switch {
+	case hasTTL:
+		record.TTL = ttl
+	case p.defaultTTL > 0:
+		record.TTL = p.defaultTTL
+	default:
+		record.TTL = p.lastTTL
+	}
+	p.lastTTL = record.TTL
+	p.lastOwner = record.Name
+
+	return record, nil
+}
+
+func (p *parser) absolute(name string) string {
+	return ExpandName(name, p.origin)
+}
+
+func (p *parser) wrap(line int, err error) error {
+	location := p.file
+	if location == "" {
+		location = "<input>"
+	}
+	if line > 0 {
+		location = fmt.Sprintf("%s:%d", location, line)
+	}
+	return fmt.Errorf("%w: %s: %s", ErrSyntax, location, err.Error())
+}
+
+// ExpandName turns a zone file name into a fully qualified name without the trailing dot.
+func ExpandName(name, origin string) string {
+	if name == "@" {
+		return origin
+	}
+	if strings.HasSuffix(name, ".") {
+		return normalizeName(name)
+	}
+	if origin == "" {
+		return name
+	}
+	return name + "." + origin
+}
+
+func normalizeName(name string) string {
+	return strings.TrimSuffix(name, ".")
+}
+
+// parseTTL accepts plain seconds as well as BIND style units, eg. 1h30m or 1w.
+func parseTTL(s string) (int, error) {
+	if s == "" {
+		return 0, fmt.Errorf("empty TTL")
+	}
+	if value, err := strconv.Atoi(s); err == nil {
+		if value < 0 {
+			return 0, fmt.Errorf("negative TTL %s", s)
+		}
+		return value, nil
+	}
+
+	units := map[byte]int{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	total, current, hasDigits := 0, 0, false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c >= '0' && c <= '9':
+			current = current*10 + int(c-'0')
+			hasDigits = true
+		case units[c|0x20] > 0 && hasDigits:
+			total += current * units[c|0x20]
+			current, hasDigits = 0, false
+		default:
+			return 0, fmt.Errorf("invalid TTL %s", s)
+		}
+	}
+	if hasDigits {
+		return 0, fmt.Errorf("invalid TTL %s", s)
+	}
+	return total, nil
+}
+
+type logicalLine struct {
+	tokens     []string
+	blankOwner bool
+	number     int
+}
+
+// tokenize splits a zone file into logical lines, joining lines inside parentheses and dropping comments.
+// Quoted strings are kept as single tokens with their quotes so the caller can tell them apart.
+func tokenize(r io.Reader) ([]logicalLine, error) {
+	reader := bufio.NewReader(r)
+	var lines []logicalLine
+	var current logicalLine
+	var token strings.Builder
+	hasToken, inQuotes, depth, lineNumber, atLineStart := false, false, 0, 1, true
+
+	flushToken := func() {
+		if hasToken {
+			current.tokens = append(current.tokens, token.String())
+			token.Reset()
+			hasToken = false
+		}
+	}
+	flushLine := func() {
+		if len(current.tokens) > 0 {
+			lines = append(lines, current)
+		}
+		current = logicalLine{}
+	}
+
+	for {
+		c, err := reader.ReadByte()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if atLineStart && depth == 0 && !inQuotes {
+			current.number = lineNumber
+			current.blankOwner = c == ' ' || c == '\t'
+		}
+		atLineStart = false
+
+		if inQuotes {
+			token.WriteByte(c)
+			switch c {
+			case '\\':
+				next, err := reader.ReadByte()
+				if err != nil {
+					return nil, fmt.Errorf("line %d: unterminated escape", lineNumber)
+				}
+				token.WriteByte(next)
+				if next == '\n' {
+					lineNumber++
+				}
+			case '"':
+				inQuotes = false
+				flushToken()
+			case '\n':
+				lineNumber++
+			}
+			continue
+		}
+
+		switch c {
+		case '"':
+			flushToken()
+			token.WriteByte(c)
+			hasToken = true
+			inQuotes = true
+		case '\\':
+			next, err := reader.ReadByte()
+			if err != nil {
+				return nil, fmt.Errorf("line %d: unterminated escape", lineNumber)
+			}
+			token.WriteByte(c)
+			token.WriteByte(next)
+			hasToken = true
+		case ';':
+			flushToken()
+			for {
+				next, err := reader.ReadByte()
+				if err != nil || next == '\n' {
+					if err == nil {
+						reader.UnreadByte()
+					}
+					break
+				}
+			}
+		case '(':
+			flushToken()
+			depth++
+		case ')':
+			flushToken()
+			if depth == 0 {
+				return nil, fmt.Errorf("line %d: unbalanced parenthesis", lineNumber)
+			}
+			depth--
+		case ' ', '\t', '\r':
+			flushToken()
+		case '\n':
+			flushToken()
+			lineNumber++
+			if depth == 0 {
+				flushLine()
+				atLineStart = true
+			}
+		default:
+			token.WriteByte(c)
+			hasToken = true
+		}
+	}
+
+	if inQuotes {
+		return nil, fmt.Errorf("line %d: unterminated quoted string", lineNumber)
+	}
+	if depth > 0 {
+		return nil, fmt.Errorf("line %d: unbalanced parenthesis", lineNumber)
+	}
+	flushToken()
+	flushLine()
+
+	return lines, nil
+}
+
+// DecodeCharacterString returns the value of a presentation-format character-string, removing quotes and resolving escapes.
+func DecodeCharacterString(s string) string {
+	if len(s) >= 2 && strings.HasPrefix(s, `"`) && strings.HasSuffix(s, `"`) {
+		s = s[1 : len(s)-1]
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 >= len(s) {
+			b.WriteByte(s[i])
+			continue
+		}
+		if i+3 < len(s) && isDigit(s[i+1]) && isDigit(s[i+2]) && isDigit(s[i+3]) {
+			value, _ := strconv.Atoi(s[i+1 : i+4])
+			b.WriteByte(byte(value))
+			i += 3
+			continue
+		}
+		b.WriteByte(s[i+1])
+		i++
+	}
+	return b.String()
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,141 @@
+package zonefile
+
+import (
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    []Record
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "it should resolve directives, relative names and blank owners",
+			input: strings.Join([]string{
+				"$ORIGIN example.com.",
+				"$TTL 1h",
+				"@ IN A 192.0.2.1 ; apex",
+				"  300 IN AAAA 2001:db8::1",
+				"www CNAME @",
+				"$ORIGIN sub.example.com.",
+				"api IN 60 A 192.0.2.2",
+				"ext.example.org. A 192.0.2.3",
+			}, "\n"),
+			want: []Record{
+				{Name: "example.com", TTL: 3600, Class: "IN", Type: "A", RData: []string{"192.0.2.1"}, Origin: "example.com", Line: 3},
+				{Name: "example.com", TTL: 300, Class: "IN", Type: "AAAA", RData: []string{"2001:db8::1"}, Origin: "example.com", Line: 4},
+				{Name: "www.example.com", TTL: 3600, Class: "IN", Type: "CNAME", RData: []string{"@"}, Origin: "example.com", Line: 5},
+				{Name: "api.sub.example.com", TTL: 60, Class: "IN", Type: "A", RData: []string{"192.0.2.2"}, Origin: "sub.example.com", Line: 7},
+				{Name: "ext.example.org", TTL: 3600, Class: "IN", Type: "A", RData: []string{"192.0.2.3"}, Origin: "sub.example.com", Line: 8},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "it should join multi-line parentheses and keep quoted strings",
+			input: strings.Join([]string{
+				"@ 3600 IN SOA ns1.example.com. admin.example.com. (",
+				"    2024010101 ; serial",
+				"    7200 3600 1209600 300 )",
+				`txt IN TXT "v=spf1 ; not a comment" "second \"part\""`,
+			}, "\n"),
+			want: []Record{
+				{Name: "example.com", TTL: 3600, Class: "IN", Type: "SOA", RData: []string{"ns1.example.com.", "admin.example.com.", "2024010101", "7200", "3600", "1209600", "300"}, Origin: "example.com", Line: 1},
+				{Name: "txt.example.com", TTL: 3600, Class: "IN", Type: "TXT", RData: []string{`"v=spf1 ; not a comment"`, `"second \"part\""`}, Origin: "example.com", Line: 4},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:  "it should reject unbalanced parentheses",
+			input: "@ IN SOA ns1 admin ( 1 2 3 4 5",
+			want:  nil,
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, ErrSyntax)
+			},
+		},
+		{
+			name:  "it should reject records without owner",
+			input: "  IN A 192.0.2.1",
+			want:  nil,
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, ErrSyntax)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(strings.NewReader(tt.input), "example.com")
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseFile_Include(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "mail.zone"), []byte("$ORIGIN mail.example.com.\n@ MX 10 mx1\n"), 0600))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "main.zone"), []byte("$TTL 300\n$INCLUDE mail.zone\nwww A 192.0.2.1\n"), 0600))
+
+	got, err := ParseFile(filepath.Join(dir, "main.zone"), "example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, []Record{
+		{Name: "mail.example.com", TTL: 300, Class: "IN", Type: "MX", RData: []string{"10", "mx1"}, Origin: "mail.example.com", File: filepath.Join(dir, "mail.zone"), Line: 2},
+		{Name: "www.example.com", TTL: 300, Class: "IN", Type: "A", RData: []string{"192.0.2.1"}, Origin: "example.com", File: filepath.Join(dir, "main.zone"), Line: 3},
+	}, got)
+}
+
+func TestRecord_ZoneRecordRequest(t *testing.T) {
+	priority := 10
+	tests := []struct {
+		name    string
+		record  Record
+		want    cloudflare.ZoneRecordRequest
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:   "it should split MX priority and expand the exchange",
+			record: Record{Name: "example.com", TTL: 300, Class: "IN", Type: "MX", RData: []string{"10", "mx1"}, Origin: "example.com"},
+			want: cloudflare.ZoneRecordRequest{
+				Type: cloudflare.ZoneTypeMX, Name: "example.com", Content: "mx1.example.com", Priority: &priority, TTL: 300, Tags: []string{},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:   "it should decode a single TXT string",
+			record: Record{Name: "example.com", Class: "IN", Type: "TXT", RData: []string{`"say \"hi\""`}, Origin: "example.com"},
+			want: cloudflare.ZoneRecordRequest{
+				Type: cloudflare.ZoneTypeTXT, Name: "example.com", Content: `say "hi"`, TTL: 1, Tags: []string{},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:   "it should build structured data with unquoted strings",
+			record: Record{Name: "example.com", Class: "IN", Type: "CAA", RData: []string{"0", "issue", `"letsencrypt.org"`}, Origin: "example.com"},
+			want: cloudflare.ZoneRecordRequest{
+				Type: cloudflare.ZoneTypeCAA, Name: "example.com", Data: cloudflare.ZoneRecordData{"flags": 0, "tag": "issue", "value": "letsencrypt.org"}, TTL: 1, Tags: []string{},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:   "it should reject unknown types",
+			record: Record{Name: "example.com", Class: "IN", Type: "WKS", RData: []string{"x"}},
+			want:   cloudflare.ZoneRecordRequest{},
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, ErrUnsupportedRecord)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.record.ZoneRecordRequest()
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package zonefile
+
+import (
+	"bufio"
+	"fmt"
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// maxCharacterString is the longest character-string allowed in a TXT record by RFC 1035.
+const maxCharacterString = 255
+
+// Write renders records as an RFC 1035 zone file with owner names relative to origin.
+// Records with Cloudflare's automatic TTL (1) inherit the $TTL directive set to defaultTTL.
+func Write(w io.Writer, origin string, defaultTTL int, records []cloudflare.ZoneRecord) error {
+	origin = strings.TrimSuffix(strings.ToLower(origin), ".")
+	sorted := append([]cloudflare.ZoneRecord{}, records...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Name != sorted[j].Name {
+			return sorted[i].Name < sorted[j].Name
+		}
+		return sorted[i].Type < sorted[j].Type
+	})
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "$ORIGIN %s.\n", origin)
+	fmt.Fprintf(bw, "$TTL %d\n", defaultTTL)
+	for _, record := range sorted {
+		ttl := ""
+		if record.TTL > 1 {
+			ttl = strconv.Itoa(record.TTL)
+		}
+		fmt.Fprintf(bw, "%s\t%s\tIN\t%s\t%s\n",
+			relativeName(origin, record.Name),
+			ttl,
+			record.Type,
+			formatRData(record),
+		)
+	}
+
+	return bw.Flush()
+}
+
+func relativeName(origin, name string) string {
+	name = strings.TrimSuffix(strings.ToLower(name), ".")
+	if name == origin {
+		return "@"
+	}
+	if strings.HasSuffix(name, "."+origin) {
+		return strings.TrimSuffix(name, "."+origin)
+	}
+	return name + "."
+}
+
+func absoluteName(name string) string {
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "."
+}
+
+func formatRData(record cloudflare.ZoneRecord) string {
+	switch record.Type {
+	case cloudflare.ZoneTypeCNAME, cloudflare.ZoneTypeNS:
+		return absoluteName(record.Content)
+	case cloudflare.ZoneTypeMX:
+		return withPriority(record, absoluteName(record.Content))
+	case cloudflare.ZoneTypePTR:
+		return absoluteName(record.Content)
+	case cloudflare.ZoneTypeSRV:
+		if record.Data != nil {
+			return fmt.Sprintf("%v %v %v %s", record.Data["priority"], record.Data["weight"], record.Data["port"], absoluteName(fmt.Sprint(record.Data["target"])))
+		}
+		fields := strings.Fields(record.Content)
+		if len(fields) > 0 {
+			fields[len(fields)-1] = absoluteName(fields[len(fields)-1])
+		}
+		return withPriority(record, strings.Join(fields, " "))
+	case cloudflare.ZoneTypeCAA:
+		if record.Data != nil {
+			return fmt.Sprintf("%v %v %s", record.Data["flags"], record.Data["tag"], quoteCharacterString(fmt.Sprint(record.Data["value"])))
+		}
+		return record.Content
+	case cloudflare.ZoneTypeTXT:
+		return QuoteTXT(record.Content)
+	default:
+		return record.Content
+	}
+}
+
+func withPriority(record cloudflare.ZoneRecord, rdata string) string {
+	if record.Priority == nil {
+		return rdata
+	}
+	return fmt.Sprintf("%d %s", *record.Priority, rdata)
+}
+
+// QuoteTXT renders TXT content as one or more quoted character-strings of at most 255 bytes each.
+// Content that is already in presentation form, such as `"v=spf1" "-all"`, is returned untouched.
+func QuoteTXT(content string) string {
+	if len(content) >= 2 && strings.HasPrefix(content, `"`) && strings.HasSuffix(content, `"`) {
+		return content
+	}
+
+	var chunks []string
+	for len(content) > maxCharacterString {
+		chunks = append(chunks, quoteCharacterString(content[:maxCharacterString]))
+		content = content[maxCharacterString:]
+	}
+	chunks = append(chunks, quoteCharacterString(content))
+
+	return strings.Join(chunks, " ")
+}
+
+func quoteCharacterString(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '"' || c == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case c < 0x20 || c > 0x7e:
+			fmt.Fprintf(&b, "\\%03d", c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
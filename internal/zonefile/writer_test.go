@@ -0,0 +1,68 @@
+package zonefile
+
+import (
+	"bytes"
+	"github.com/jorgejr568/cloudflare-cli/internal/clients/cloudflare"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestWrite(t *testing.T) {
+	priority := 10
+	records := []cloudflare.ZoneRecord{
+		{Name: "www.example.com", Type: cloudflare.ZoneTypeCNAME, Content: "example.com", TTL: 1},
+		{Name: "example.com", Type: cloudflare.ZoneTypeA, Content: "192.0.2.1", TTL: 3600},
+		{Name: "example.com", Type: cloudflare.ZoneTypeMX, Content: "mail.example.com", TTL: 1, Priority: &priority},
+		{Name: "_sip._tcp.example.com", Type: cloudflare.ZoneTypeSRV, Content: "5 5060 sip.example.com", TTL: 1, Priority: &priority},
+		{Name: "example.com", Type: cloudflare.ZoneTypeTXT, Content: `v=spf1 include:"x" -all`, TTL: 1},
+	}
+
+	var buf bytes.Buffer
+	err := Write(&buf, "example.com", 300, records)
+	assert.NoError(t, err)
+	assert.Equal(t, strings.Join([]string{
+		"$ORIGIN example.com.",
+		"$TTL 300",
+		"_sip._tcp\t\tIN\tSRV\t10 5 5060 sip.example.com.",
+		"@\t3600\tIN\tA\t192.0.2.1",
+		"@\t\tIN\tMX\t10 mail.example.com.",
+		"@\t\tIN\tTXT\t\"v=spf1 include:\\\"x\\\" -all\"",
+		"www\t\tIN\tCNAME\texample.com.",
+		"",
+	}, "\n"), buf.String())
+}
+
+func TestQuoteTXT(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{
+			name:    "it should quote short content",
+			content: "hello world",
+			want:    `"hello world"`,
+		},
+		{
+			name:    "it should keep content already in presentation form",
+			content: `"part one" "part two"`,
+			want:    `"part one" "part two"`,
+		},
+		{
+			name:    "it should split content longer than 255 bytes",
+			content: strings.Repeat("a", 300),
+			want:    `"` + strings.Repeat("a", 255) + `" "` + strings.Repeat("a", 45) + `"`,
+		},
+		{
+			name:    "it should escape non printable bytes",
+			content: "tab\there",
+			want:    `"tab\009here"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, QuoteTXT(tt.content))
+		})
+	}
+}